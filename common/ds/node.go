@@ -242,11 +242,36 @@ func unmarshalYAMLItem[K comparable, V any](value any, node *Node[K, V]) {
 				break
 			}
 		}
+
+		if rows, ok := yamlRawValue(val).(V); ok {
+			node.Value = rows
+		}
 	default:
 		unmarshalValue(node, val)
 	}
 }
 
+// yamlRawValue converts YAML map slices to plain maps, so that lists of
+// objects keep their rows alongside the per-key children of the node.
+func yamlRawValue(value any) any {
+	switch val := value.(type) {
+	case yaml.MapSlice:
+		rawMap := make(map[string]any, len(val))
+		for _, item := range val {
+			rawMap[fmt.Sprint(item.Key)] = yamlRawValue(item.Value)
+		}
+		return rawMap
+	case []any:
+		elements := make([]any, len(val))
+		for idx, elem := range val {
+			elements[idx] = yamlRawValue(elem)
+		}
+		return elements
+	default:
+		return value
+	}
+}
+
 func unmarshalValue[K comparable, V any](nodeRef *Node[K, V], value any) {
 	if valMap, ok := value.(map[K]any); ok {
 		for key, v := range valMap {
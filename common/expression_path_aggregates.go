@@ -0,0 +1,130 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/conformize/conformize/common/ds"
+	commonfns "github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/path"
+)
+
+// aggregate reduces the elements of a list, or the values of a map, to a single value.
+// Elements lacking the sub-key of the step are skipped.
+func aggregate(node *ds.Node[string, any], step path.AggregateStep) (*ds.Node[string, any], error) {
+	elements, err := aggregatedElements(commonfns.NodeRawValue(node), step.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", step.String(), err)
+	}
+
+	vNode := ds.NewNode[string, any]()
+	vNode.Key = step.String()
+	if step.Function == "count" {
+		vNode.Value = len(elements)
+		return vNode, nil
+	}
+
+	numbers := make([]float64, 0, len(elements))
+	for idx, elem := range elements {
+		num, ok := numberOf(elem)
+		if !ok {
+			return nil, fmt.Errorf("%s: element %d is not a number", step.String(), idx+1)
+		}
+		numbers = append(numbers, num)
+	}
+
+	if len(numbers) == 0 && step.Function != "sum" {
+		return nil, fmt.Errorf("%s: no elements to aggregate", step.String())
+	}
+
+	var result float64
+	switch step.Function {
+	case "sum", "avg":
+		for _, num := range numbers {
+			result += num
+		}
+
+		if step.Function == "avg" {
+			result /= float64(len(numbers))
+		}
+	case "min":
+		result = numbers[0]
+		for _, num := range numbers[1:] {
+			result = min(result, num)
+		}
+	case "max":
+		result = numbers[0]
+		for _, num := range numbers[1:] {
+			result = max(result, num)
+		}
+	default:
+		return nil, fmt.Errorf("unknown function '%s'", step.Function)
+	}
+	vNode.Value = result
+	return vNode, nil
+}
+
+func aggregatedElements(raw any, keys []string) ([]any, error) {
+	var elements []any
+	switch val := raw.(type) {
+	case []any:
+		elements = val
+	case map[string]any:
+		elements = make([]any, 0, len(val))
+		for _, elem := range val {
+			elements = append(elements, elem)
+		}
+	default:
+		return nil, fmt.Errorf("value of type %T can't be aggregated", raw)
+	}
+
+	if len(keys) == 0 {
+		return elements, nil
+	}
+
+	projected := make([]any, 0, len(elements))
+	for _, elem := range elements {
+		if projectedElem, ok := subKeyValue(elem, keys); ok {
+			projected = append(projected, projectedElem)
+		}
+	}
+	return projected, nil
+}
+
+func subKeyValue(elem any, keys []string) (any, bool) {
+	current := elem
+	for _, key := range keys {
+		var found bool
+		switch fields := current.(type) {
+		case map[string]any:
+			current, found = fields[key]
+		case map[any]any:
+			current, found = fields[key]
+		}
+
+		if !found {
+			return nil, false
+		}
+	}
+	return current, current != nil
+}
+
+func numberOf(raw any) (float64, bool) {
+	val := reflect.ValueOf(raw)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(val.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(val.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return val.Float(), true
+	}
+	return 0, false
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/common/typed/functions"
 	"github.com/conformize/conformize/predicates"
+	unmarshalfns "github.com/conformize/conformize/serialization/unmarshal/functions"
 )
 
 type ExpressionPathEvaluator struct{}
@@ -40,13 +41,17 @@ func (vpeval *ExpressionPathEvaluator) Evaluate(node *ds.Node[string, any], p *p
 
 	var err error
 	var v typed.Valuable
-walkLoop:
+	var resolved path.Steps
 	for nextStep != nil {
 		switch nextStep := nextStep.(type) {
 		case path.ObjectStep, path.KeyStep:
 			children, ok = current.GetChildren(nextStep.String())
 			if !ok {
-				break walkLoop
+				return nil, &UnresolvedPathError{
+					Resolved:    resolved,
+					Key:         nextStep.String(),
+					Suggestions: suggestSteps(current, nextStep.String(), steps.Clone()),
+				}
 			}
 			current = children.First()
 		case path.AttributeStep:
@@ -110,11 +115,11 @@ walkLoop:
 				return nil, fmt.Errorf("unknown function '%s'", nextStep.String())
 			}
 			return vNode, nil
+		case path.AggregateStep:
+			return aggregate(current, nextStep)
+		case path.WildcardStep, path.RecursiveStep, path.FilterStep:
+			return vpeval.matchSteps(current, append(path.Steps{nextStep}, steps...))
 		case path.IndexStep:
-			if children == nil {
-				return nil, fmt.Errorf("index step '%s' cannot be applied without a preceding key", nextStep.String())
-			}
-
 			idx, err := strconv.Atoi(nextStep.String())
 			if err != nil {
 				return nil, fmt.Errorf("invalid index '%s': %w", nextStep.String(), err)
@@ -125,21 +130,47 @@ walkLoop:
 				if reflectVal.Type().Kind() == reflect.Slice &&
 					!reflectVal.IsNil() && typed.TypeHintOf(reflectVal).TypeHint() == typed.List {
 
-					if idx < 0 || idx >= reflectVal.Len() {
-						return nil, fmt.Errorf("index %d out of range [0, %d)", idx+1, reflectVal.Len())
+					elemIdx, inRange := listIndex(idx, reflectVal.Len())
+					if !inRange {
+						return nil, fmt.Errorf("index %d out of range [0, %d)", idx, reflectVal.Len())
 					}
 					vNode := ds.NewNode[string, any]()
 					vNode.Key = nextStep.String()
-					vNode.Value = reflectVal.Index(idx).Interface()
-					return vNode, nil
+					elem := reflectVal.Index(elemIdx).Interface()
+					if _, isMap := elem.(map[string]any); !isMap {
+						vNode.Value = elem
+						current = vNode
+						break
+					}
+
+					unmarshalfns.UnmarshalValue(vNode, elem)
+					current = vNode
+					break
 				}
 			}
 
-			if idx < 0 || idx >= children.Count() {
-				return nil, fmt.Errorf("index %d out of range [0, %d)", idx+1, children.Count())
+			if children == nil {
+				return nil, fmt.Errorf("index step '%s' cannot be applied without a preceding key", nextStep.String())
 			}
 
-			current = children.Get(idx)
+			childIdx, inRange := listIndex(idx, children.Count())
+			if !inRange {
+				return nil, fmt.Errorf("index %d out of range [0, %d)", idx, children.Count())
+			}
+
+			current = children.Get(childIdx)
+		case path.SliceStep:
+			if current, err = slice(current, children, nextStep); err != nil {
+				return nil, err
+			}
+		case path.ProjectionStep:
+			if current, err = project(current, nextStep); err != nil {
+				return nil, err
+			}
+		case path.TransformStep:
+			if current, err = transform(current, nextStep); err != nil {
+				return nil, err
+			}
 		case *path.AttributeStep:
 			if attr, ok := current.GetAttribute(nextStep.String()); ok {
 				return attr, nil
@@ -148,6 +179,7 @@ walkLoop:
 		default:
 			return nil, fmt.Errorf("invalid step in path")
 		}
+		resolved = append(resolved, nextStep)
 		nextStep, _ = steps.Next()
 	}
 
@@ -0,0 +1,131 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package common
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/conformize/conformize/common/ds"
+	commonfns "github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/path"
+)
+
+// matchSteps applies wildcard, recursive descent and filter steps to the value of a node,
+// collecting the matching values into a list. The steps following the matching ones,
+// e.g. each or count, are applied to the list.
+func (vpeval *ExpressionPathEvaluator) matchSteps(node *ds.Node[string, any], steps path.Steps) (*ds.Node[string, any], error) {
+	matchingSteps, remainingSteps := steps, path.Steps{}
+	for idx, step := range steps {
+		switch step.(type) {
+		case path.FunctionStep, path.AggregateStep, path.PropertyStep, path.ProjectionStep, path.TransformStep:
+			matchingSteps, remainingSteps = steps[:idx], steps[idx:]
+		default:
+			continue
+		}
+		break
+	}
+
+	matches := []any{commonfns.NodeRawValue(node)}
+	for idx := 0; idx < len(matchingSteps); idx++ {
+		step := matchingSteps[idx]
+		if _, isRecursive := step.(path.RecursiveStep); isRecursive {
+			var descendants []any
+			for _, match := range matches {
+				descendants = collectDescendants(match, descendants)
+			}
+
+			if idx++; idx == len(matchingSteps) {
+				matches = descendants
+				break
+			}
+			matches, step = descendants, matchingSteps[idx]
+		}
+
+		var err error
+		if matches, err = matchStep(matches, step); err != nil {
+			return nil, err
+		}
+	}
+
+	vNode := ds.NewNode[string, any]()
+	vNode.Key = node.Key
+	vNode.Value = matches
+	if len(remainingSteps) == 0 {
+		return vNode, nil
+	}
+	return vpeval.Evaluate(vNode, path.NewPath(remainingSteps))
+}
+
+func matchStep(values []any, step path.PathStep) ([]any, error) {
+	matches := []any{}
+	for _, value := range values {
+		switch step := step.(type) {
+		case path.KeyStep:
+			if fields, isMap := value.(map[string]any); isMap {
+				if val, found := fields[step.String()]; found {
+					matches = append(matches, val)
+				}
+			}
+		case path.IndexStep:
+			idx, err := strconv.Atoi(step.String())
+			if err != nil {
+				return nil, fmt.Errorf("invalid index '%s': %w", step.String(), err)
+			}
+
+			if elements, isList := value.([]any); isList {
+				if elemIdx, inRange := listIndex(idx, len(elements)); inRange {
+					matches = append(matches, elements[elemIdx])
+				}
+			}
+		case path.SliceStep:
+			if elements, isList := value.([]any); isList {
+				if start, end := sliceRange(step, len(elements)); start < end {
+					matches = append(matches, elements[start:end]...)
+				}
+			}
+		case path.WildcardStep:
+			matches = append(matches, childValues(value)...)
+		case path.FilterStep:
+			for _, child := range childValues(value) {
+				if step.Expression.Matches(child) {
+					matches = append(matches, child)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("step '%s' cannot follow a wildcard, a recursive descent or a filter", step.String())
+		}
+	}
+	return matches, nil
+}
+
+// childValues returns the elements of a list, or the values of a map ordered by key.
+func childValues(value any) []any {
+	switch val := value.(type) {
+	case []any:
+		return val
+	case map[string]any:
+		children := make([]any, 0, len(val))
+		for _, key := range slices.Sorted(maps.Keys(val)) {
+			children = append(children, val[key])
+		}
+		return children
+	}
+	return nil
+}
+
+// collectDescendants appends a value and all values nested in it, depth first.
+func collectDescendants(value any, descendants []any) []any {
+	descendants = append(descendants, value)
+	for _, child := range childValues(value) {
+		descendants = collectDescendants(child, descendants)
+	}
+	return descendants
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package common
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/conformize/conformize/common/ds"
+	commonfns "github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/path"
+)
+
+// listIndex resolves an index into a list of the given length, counting negative indexes from its end.
+func listIndex(idx int, length int) (int, bool) {
+	if idx < 0 {
+		idx += length
+	}
+	return idx, idx >= 0 && idx < length
+}
+
+// slice selects a range of the elements of a list. Bounds out of range are clamped to the list.
+func slice(node *ds.Node[string, any], children ds.NodeList[string, any], step path.SliceStep) (*ds.Node[string, any], error) {
+	elements, isList := node.Value.([]any)
+	if !isList && children.Count() > 1 && children.First() == node {
+		elements = make([]any, 0, children.Count())
+		for _, child := range children {
+			elements = append(elements, commonfns.NodeRawValue(child))
+		}
+		isList = true
+	}
+
+	if !isList {
+		return nil, fmt.Errorf("%s: value is not a list", step.String())
+	}
+
+	vNode := ds.NewNode[string, any]()
+	vNode.Key = step.String()
+	vNode.Value = []any{}
+	if start, end := sliceRange(step, len(elements)); start < end {
+		vNode.Value = slices.Clone(elements[start:end])
+	}
+	return vNode, nil
+}
+
+// sliceRange resolves the bounds of a slice step into a list of the given length.
+func sliceRange(step path.SliceStep, length int) (int, int) {
+	start, end := 0, length
+	if step.Start != nil {
+		start = sliceBound(*step.Start, length)
+	}
+
+	if step.End != nil {
+		end = sliceBound(*step.End, length)
+	}
+	return start, end
+}
+
+func sliceBound(bound int, length int) int {
+	if bound < 0 {
+		bound += length
+	}
+	return min(max(bound, 0), length)
+}
+
+// project turns a map into a list of its keys, values or entries, ordered by key.
+// An entry is a map with the fields "key" and "value".
+func project(node *ds.Node[string, any], step path.ProjectionStep) (*ds.Node[string, any], error) {
+	fields, isMap := commonfns.NodeRawValue(node).(map[string]any)
+	if !isMap {
+		return nil, fmt.Errorf("%s: value is not a map", step.String())
+	}
+
+	projected := make([]any, 0, len(fields))
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		switch step {
+		case "keys":
+			projected = append(projected, key)
+		case "values":
+			projected = append(projected, fields[key])
+		case "entries":
+			projected = append(projected, map[string]any{"key": key, "value": fields[key]})
+		default:
+			return nil, fmt.Errorf("unknown function '%s'", step.String())
+		}
+	}
+
+	vNode := ds.NewNode[string, any]()
+	vNode.Key = step.String()
+	vNode.Value = projected
+	return vNode, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package common
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/util"
+)
+
+const maxSuggestions = 3
+
+// UnresolvedPathError reports a key which couldn't be found while walking a path,
+// along with the deepest resolved prefix of the path and similar keys found there.
+type UnresolvedPathError struct {
+	Resolved    path.Steps
+	Key         string
+	Suggestions []path.Steps
+}
+
+func (e *UnresolvedPathError) Error() string {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "key '%s' not found", e.Key)
+	if len(e.Resolved) > 0 {
+		fmt.Fprintf(&msg, " in %s", e.Resolved.String())
+	}
+
+	if len(e.Suggestions) > 0 {
+		suggestions := make([]string, 0, len(e.Suggestions))
+		for _, suggestion := range e.Suggestions {
+			suggestions = append(suggestions, append(e.Resolved.Clone(), suggestion...).String())
+		}
+		fmt.Fprintf(&msg, ", did you mean %s?", strings.Join(suggestions, " or "))
+	}
+	return msg.String()
+}
+
+// suggestSteps looks for keys of a node resembling a missing one - differing only in case or by
+// a few characters, or holding a dotted key which was written as nested keys, and vice versa.
+// The suggested steps replace the missing key and the steps following it.
+func suggestSteps(node *ds.Node[string, any], key string, remaining path.Steps) []path.Steps {
+	siblings := slices.Sorted(maps.Keys(node.Children()))
+	var suggestions []path.Steps
+
+	dottedKey := key
+	for idx, step := range remaining {
+		keyStep, isKey := step.(path.KeyStep)
+		if !isKey {
+			break
+		}
+
+		dottedKey += "." + keyStep.String()
+		if slices.Contains(siblings, dottedKey) {
+			suggestions = append(suggestions, append(path.Steps{path.KeyStep(dottedKey)}, remaining[idx+1:]...))
+			break
+		}
+	}
+
+	if nested := nestedKeySteps(node, key); nested != nil {
+		suggestions = append(suggestions, append(nested, remaining...))
+	}
+
+	type candidate struct {
+		key      string
+		distance int
+	}
+
+	var candidates []candidate
+	maxDistance := max(1, len(key)/4)
+	for _, sibling := range siblings {
+		if strings.EqualFold(sibling, key) {
+			candidates = append(candidates, candidate{key: sibling})
+			continue
+		}
+
+		if distance := util.EditDistance(strings.ToLower(sibling), strings.ToLower(key)); distance <= maxDistance {
+			candidates = append(candidates, candidate{key: sibling, distance: distance})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return a.distance - b.distance
+	})
+
+	for _, c := range candidates {
+		suggestions = append(suggestions, append(path.Steps{path.KeyStep(c.key)}, remaining...))
+	}
+
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	return suggestions
+}
+
+// nestedKeySteps resolves a dotted key, e.g. 'pool.size', as nested keys of a node.
+func nestedKeySteps(node *ds.Node[string, any], key string) path.Steps {
+	parts := strings.Split(key, ".")
+	if len(parts) < 2 {
+		return nil
+	}
+
+	steps := make(path.Steps, 0, len(parts))
+	current := node
+	for _, part := range parts {
+		children, found := current.GetChildren(part)
+		if !found {
+			return nil
+		}
+		current = children.First()
+		steps = append(steps, path.KeyStep(part))
+	}
+	return steps
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/conformize/conformize/common/ds"
+	commonfns "github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/path"
+	unmarshalfns "github.com/conformize/conformize/serialization/unmarshal/functions"
+	"gopkg.in/yaml.v3"
+)
+
+// transform decodes a string value into a new node, so that the following steps can address its content.
+func transform(node *ds.Node[string, any], step path.TransformStep) (*ds.Node[string, any], error) {
+	str, isString := commonfns.NodeRawValue(node).(string)
+	if !isString {
+		return nil, fmt.Errorf("%s: value is not a string", step.String())
+	}
+
+	var decoded any
+	var err error
+	switch step.Function {
+	case "fromJson":
+		err = json.Unmarshal([]byte(str), &decoded)
+	case "fromYaml":
+		if err = yaml.Unmarshal([]byte(str), &decoded); err == nil {
+			decoded = normalizeDecodedValue(decoded)
+		}
+	case "fromBase64":
+		decoded, err = decodeBase64(str)
+	case "parseUrl":
+		decoded, err = parseUrl(str)
+	case "split":
+		parts := strings.Split(str, step.Arguments[0])
+		elements := make([]any, 0, len(parts))
+		for _, part := range parts {
+			elements = append(elements, part)
+		}
+		decoded = elements
+	default:
+		return nil, fmt.Errorf("unknown function '%s'", step.Function)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", step.String(), err)
+	}
+
+	vNode := ds.NewNode[string, any]()
+	vNode.Key = step.String()
+	if decoded != nil {
+		unmarshalfns.UnmarshalValue(vNode, decoded)
+	}
+	return vNode, nil
+}
+
+func decodeBase64(str string) (string, error) {
+	str = strings.TrimSpace(str)
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(str); err == nil {
+			return string(decoded), nil
+		}
+	}
+	return "", fmt.Errorf("value is not base64 encoded")
+}
+
+// parseUrl splits a URL into its components. Query parameters with a single value
+// are strings, while repeated ones are lists.
+func parseUrl(str string) (map[string]any, error) {
+	parsedUrl, err := url.Parse(strings.TrimSpace(str))
+	if err != nil {
+		return nil, err
+	}
+
+	query := make(map[string]any)
+	for key, values := range parsedUrl.Query() {
+		if len(values) == 1 {
+			query[key] = values[0]
+			continue
+		}
+
+		elements := make([]any, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, value)
+		}
+		query[key] = elements
+	}
+
+	components := map[string]any{
+		"scheme":   parsedUrl.Scheme,
+		"host":     parsedUrl.Host,
+		"hostname": parsedUrl.Hostname(),
+		"port":     parsedUrl.Port(),
+		"path":     parsedUrl.Path,
+		"query":    query,
+		"fragment": parsedUrl.Fragment,
+	}
+
+	if parsedUrl.User != nil {
+		components["username"] = parsedUrl.User.Username()
+		if password, isSet := parsedUrl.User.Password(); isSet {
+			components["password"] = password
+		}
+	}
+	return components, nil
+}
+
+// normalizeDecodedValue converts maps with non-string keys, which YAML allows, to maps with string keys.
+func normalizeDecodedValue(value any) any {
+	switch val := value.(type) {
+	case map[string]any:
+		for key, elem := range val {
+			val[key] = normalizeDecodedValue(elem)
+		}
+		return val
+	case map[any]any:
+		fields := make(map[string]any, len(val))
+		for key, elem := range val {
+			fields[fmt.Sprint(key)] = normalizeDecodedValue(elem)
+		}
+		return fields
+	case []any:
+		for idx, elem := range val {
+			val[idx] = normalizeDecodedValue(elem)
+		}
+		return val
+	}
+	return value
+}
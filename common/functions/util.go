@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"regexp"
 
+	"github.com/conformize/conformize/common/ds"
 	"github.com/conformize/conformize/common/reflected"
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/serialization/unmarshal/functions"
@@ -102,3 +103,94 @@ func InterpolateEnvVars(v string) (string, error) {
 	}
 	return v, nil
 }
+
+// NodeRawValue returns the value held by a node, or for object nodes a map
+// built from their children, where repeated keys are collected into lists.
+func NodeRawValue(node *ds.Node[string, any]) any {
+	if node == nil {
+		return nil
+	}
+
+	if node.Value != nil {
+		return node.Value
+	}
+
+	children := node.Children()
+	if len(children) == 0 {
+		return nil
+	}
+
+	rawMap := make(map[string]any, len(children))
+	for key, nodeList := range children {
+		if nodeList.Count() == 1 {
+			rawMap[key] = NodeRawValue(nodeList.First())
+			continue
+		}
+
+		elements := make([]any, 0, nodeList.Count())
+		for _, child := range nodeList {
+			elements = append(elements, NodeRawValue(child))
+		}
+		rawMap[key] = elements
+	}
+	return rawMap
+}
+
+// RawValueOf converts a typed value back into plain Go values - strings, float64
+// numbers, booleans, []any and map[string]any - e.g. for encoding it as JSON.
+func RawValueOf(value typed.Valuable) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch val := value.(type) {
+	case *typed.GenericValue:
+		return RawValueOf(val.Value)
+	case *typed.VariantValue:
+		return RawValueOf(val.Value)
+	case *typed.MapValue:
+		return rawFieldsOf(val.Elements)
+	case *typed.ObjectValue:
+		return rawFieldsOf(val.Fields)
+	case typed.Elementable:
+		items := val.Items()
+		elements := make([]any, 0, len(items))
+		for _, item := range items {
+			element, err := RawValueOf(item)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, element)
+		}
+		return elements, nil
+	}
+
+	switch value.Type().Hint().TypeHint() {
+	case typed.String:
+		var s string
+		err := value.As(&s)
+		return s, err
+	case typed.Number:
+		var n float64
+		err := value.As(&n)
+		return n, err
+	case typed.Boolean:
+		var b bool
+		err := value.As(&b)
+		return b, err
+	default:
+		return nil, fmt.Errorf("unsupported value of type %s", value.Type().Name())
+	}
+}
+
+func rawFieldsOf(fields map[string]typed.Valuable) (map[string]any, error) {
+	rawFields := make(map[string]any, len(fields))
+	for key, field := range fields {
+		rawField, err := RawValueOf(field)
+		if err != nil {
+			return nil, err
+		}
+		rawFields[key] = rawField
+	}
+	return rawFields, nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package path
+
+import "strings"
+
+// AggregateStep reduces a collection to a single value, optionally projecting
+// each of its elements through a sub-key first, e.g. sum('resources'.'cpu').
+type AggregateStep struct {
+	Function string
+	Keys     []string
+}
+
+func (a AggregateStep) String() string {
+	if len(a.Keys) == 0 {
+		return a.Function
+	}
+	return a.Function + "('" + strings.Join(a.Keys, "'.'") + "')"
+}
+
+func (a AggregateStep) Equal(o PathStep) bool {
+	other, ok := o.(AggregateStep)
+	if ok {
+		return a.String() == other.String()
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package path
+
+// FilterExpression tells whether an element of a collection is selected by a filter step.
+type FilterExpression interface {
+	Matches(element any) bool
+	String() string
+}
+
+// FilterStep selects the elements of a list, or the values of a map, matching an expression,
+// e.g. [?(@.'enabled' == true)].
+type FilterStep struct {
+	Expression FilterExpression
+}
+
+func (f FilterStep) String() string {
+	return "[?(" + f.Expression.String() + ")]"
+}
+
+func (f FilterStep) Equal(o PathStep) bool {
+	other, ok := o.(FilterStep)
+	if ok {
+		return f.String() == other.String()
+	}
+	return false
+}
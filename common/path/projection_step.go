@@ -0,0 +1,23 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package path
+
+// ProjectionStep turns a map into a list of its keys, values or entries.
+type ProjectionStep string
+
+func (p ProjectionStep) String() string {
+	return string(p)
+}
+
+func (p ProjectionStep) Equal(o PathStep) bool {
+	other, ok := o.(ProjectionStep)
+	if ok {
+		return p == other
+	}
+	return false
+}
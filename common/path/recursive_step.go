@@ -0,0 +1,21 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package path
+
+// RecursiveStep matches a value and all of its descendants, so the step following it
+// applies at any depth, e.g. $src..'password'.
+type RecursiveStep struct{}
+
+func (r RecursiveStep) String() string {
+	return ""
+}
+
+func (r RecursiveStep) Equal(o PathStep) bool {
+	_, ok := o.(RecursiveStep)
+	return ok
+}
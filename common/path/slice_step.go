@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package path
+
+import "strconv"
+
+// SliceStep selects the elements of a list from Start up to, but excluding, End, e.g. [1:3].
+// Negative bounds count from the end of the list and nil bounds extend to its edges.
+type SliceStep struct {
+	Start *int
+	End   *int
+}
+
+func (s SliceStep) String() string {
+	bounds := ""
+	if s.Start != nil {
+		bounds += strconv.Itoa(*s.Start)
+	}
+	bounds += ":"
+	if s.End != nil {
+		bounds += strconv.Itoa(*s.End)
+	}
+	return "[" + bounds + "]"
+}
+
+func (s SliceStep) Equal(o PathStep) bool {
+	other, ok := o.(SliceStep)
+	if ok {
+		return s.String() == other.String()
+	}
+	return false
+}
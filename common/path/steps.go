@@ -33,8 +33,15 @@ func (s Steps) String() string {
 
 	var result strings.Builder
 	for i, step := range s {
-		if i > 0 {
-			result.WriteRune('.')
+		switch step.(type) {
+		case FilterStep, SliceStep:
+			if i > 0 && s[i-1].Equal(RecursiveStep{}) {
+				result.WriteRune('.')
+			}
+		default:
+			if i > 0 {
+				result.WriteRune('.')
+			}
 		}
 
 		switch step.(type) {
@@ -0,0 +1,40 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package path
+
+import "strings"
+
+// TransformStep decodes the value of a node into a new value, e.g. fromJson or split(',').
+type TransformStep struct {
+	Function  string
+	Arguments []string
+}
+
+func (t TransformStep) String() string {
+	if len(t.Arguments) == 0 {
+		return t.Function
+	}
+
+	args := make([]string, 0, len(t.Arguments))
+	for _, arg := range t.Arguments {
+		quote := "'"
+		if strings.Contains(arg, quote) {
+			quote = "\""
+		}
+		args = append(args, quote+arg+quote)
+	}
+	return t.Function + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (t TransformStep) Equal(o PathStep) bool {
+	other, ok := o.(TransformStep)
+	if ok {
+		return t.String() == other.String()
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package path
+
+// WildcardStep matches every key of a map or every element of a list.
+type WildcardStep struct{}
+
+func (w WildcardStep) String() string {
+	return "*"
+}
+
+func (w WildcardStep) Equal(o PathStep) bool {
+	_, ok := o.(WildcardStep)
+	return ok
+}
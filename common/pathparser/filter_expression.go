@@ -0,0 +1,444 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package pathparser
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type filterExpression interface {
+	evaluate(element any) any
+}
+
+// filter is a parsed filter step expression, e.g. @.'enabled' == true && @.'port' > 1024.
+type filter struct {
+	source string
+	root   filterExpression
+}
+
+func (f *filter) Matches(element any) bool {
+	return truthy(f.root.evaluate(element))
+}
+
+func (f *filter) String() string {
+	return f.source
+}
+
+type filterLogical struct {
+	op          string
+	left, right filterExpression
+}
+
+func (expr *filterLogical) evaluate(element any) any {
+	left := truthy(expr.left.evaluate(element))
+	if expr.op == "&&" {
+		return left && truthy(expr.right.evaluate(element))
+	}
+	return left || truthy(expr.right.evaluate(element))
+}
+
+type filterNot struct {
+	operand filterExpression
+}
+
+func (expr *filterNot) evaluate(element any) any {
+	return !truthy(expr.operand.evaluate(element))
+}
+
+type filterComparison struct {
+	op          string
+	left, right filterExpression
+}
+
+func (expr *filterComparison) evaluate(element any) any {
+	left, right := expr.left.evaluate(element), expr.right.evaluate(element)
+	switch expr.op {
+	case "==":
+		return filterValuesEqual(left, right)
+	case "!=":
+		return !filterValuesEqual(left, right)
+	}
+
+	if leftNum, leftOk := filterNumber(left); leftOk {
+		if rightNum, rightOk := filterNumber(right); rightOk {
+			return compareOrdered(leftNum, rightNum, expr.op)
+		}
+		return false
+	}
+
+	leftStr, leftOk := left.(string)
+	rightStr, rightOk := right.(string)
+	if leftOk && rightOk {
+		return compareOrdered(leftStr, rightStr, expr.op)
+	}
+	return false
+}
+
+func compareOrdered[T float64 | string](left, right T, op string) bool {
+	switch op {
+	case "<":
+		return left < right
+	case "<=":
+		return left <= right
+	case ">":
+		return left > right
+	case ">=":
+		return left >= right
+	}
+	return false
+}
+
+// filterMissing marks a relative path which doesn't exist in an element.
+type filterMissing struct{}
+
+type filterPath struct {
+	steps []any
+}
+
+func (expr *filterPath) evaluate(element any) any {
+	current := element
+	for _, step := range expr.steps {
+		switch key := step.(type) {
+		case string:
+			switch fields := current.(type) {
+			case map[string]any:
+				val, found := fields[key]
+				if !found {
+					return filterMissing{}
+				}
+				current = val
+			case map[any]any:
+				val, found := fields[key]
+				if !found {
+					return filterMissing{}
+				}
+				current = val
+			default:
+				return filterMissing{}
+			}
+		case int:
+			elements, isList := current.([]any)
+			if !isList {
+				return filterMissing{}
+			}
+
+			if key < 0 {
+				key += len(elements)
+			}
+
+			if key < 0 || key >= len(elements) {
+				return filterMissing{}
+			}
+			current = elements[key]
+		}
+	}
+	return current
+}
+
+type filterLiteral struct {
+	value any
+}
+
+func (expr *filterLiteral) evaluate(_ any) any {
+	return expr.value
+}
+
+func truthy(value any) bool {
+	switch val := value.(type) {
+	case filterMissing, nil:
+		return false
+	case bool:
+		return val
+	}
+	return true
+}
+
+func filterNumber(value any) (float64, bool) {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(val.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(val.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return val.Float(), true
+	}
+	return 0, false
+}
+
+func filterValuesEqual(left, right any) bool {
+	if _, isMissing := left.(filterMissing); isMissing {
+		return false
+	}
+
+	if _, isMissing := right.(filterMissing); isMissing {
+		return false
+	}
+
+	if leftNum, leftOk := filterNumber(left); leftOk {
+		rightNum, rightOk := filterNumber(right)
+		return rightOk && leftNum == rightNum
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+type filterTokenKind int
+
+const (
+	filterEnd filterTokenKind = iota
+	filterAt
+	filterDot
+	filterKey
+	filterIndex
+	filterString
+	filterNumberLiteral
+	filterWord
+	filterOperator
+	filterOpenParen
+	filterCloseParen
+)
+
+type filterToken struct {
+	kind  filterTokenKind
+	value string
+	pos   int
+}
+
+func tokenizeFilter(source string) ([]filterToken, error) {
+	var tokens []filterToken
+	prevKind := filterEnd
+	for pos := 0; pos < len(source); {
+		ch := source[pos]
+		start := pos
+		switch {
+		case ch == ' ' || ch == '\t':
+			pos++
+			continue
+		case ch == '@':
+			pos++
+			tokens = append(tokens, filterToken{kind: filterAt, value: "@", pos: start})
+		case ch == '.':
+			pos++
+			tokens = append(tokens, filterToken{kind: filterDot, value: ".", pos: start})
+		case ch == '(':
+			pos++
+			tokens = append(tokens, filterToken{kind: filterOpenParen, value: "(", pos: start})
+		case ch == ')':
+			pos++
+			tokens = append(tokens, filterToken{kind: filterCloseParen, value: ")", pos: start})
+		case ch == '\'' || ch == '"':
+			end := strings.IndexByte(source[pos+1:], ch)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quote at position %d", start)
+			}
+			kind := filterString
+			if prevKind == filterDot {
+				kind = filterKey
+			}
+			tokens = append(tokens, filterToken{kind: kind, value: source[pos+1 : pos+1+end], pos: start})
+			pos += end + 2
+		case ch >= '0' && ch <= '9' || ch == '-':
+			pos++
+			for pos < len(source) && (source[pos] >= '0' && source[pos] <= '9' || source[pos] == '.' && prevKind != filterDot) {
+				pos++
+			}
+			kind := filterNumberLiteral
+			if prevKind == filterDot {
+				kind = filterIndex
+			}
+			tokens = append(tokens, filterToken{kind: kind, value: source[start:pos], pos: start})
+		case ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z':
+			for pos < len(source) && (source[pos] >= 'a' && source[pos] <= 'z' || source[pos] >= 'A' && source[pos] <= 'Z') {
+				pos++
+			}
+			tokens = append(tokens, filterToken{kind: filterWord, value: source[start:pos], pos: start})
+		default:
+			op := ""
+			for _, candidate := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!"} {
+				if strings.HasPrefix(source[pos:], candidate) {
+					op = candidate
+					break
+				}
+			}
+
+			if len(op) == 0 {
+				return nil, fmt.Errorf("unexpected character '%c' at position %d", ch, start)
+			}
+			pos += len(op)
+			tokens = append(tokens, filterToken{kind: filterOperator, value: op, pos: start})
+		}
+		prevKind = tokens[len(tokens)-1].kind
+	}
+	return append(tokens, filterToken{kind: filterEnd, pos: len(source)}), nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+// parseFilter parses the expression of a filter step, e.g. [?(@.'enabled' == true)].
+func parseFilter(filterStr string) (*filter, error) {
+	if !strings.HasPrefix(filterStr, "[?(") || !strings.HasSuffix(filterStr, ")]") {
+		return nil, fmt.Errorf("expected filter in the form [?(expression)], got %s", filterStr)
+	}
+
+	source := strings.TrimSpace(filterStr[3 : len(filterStr)-2])
+	tokens, err := tokenizeFilter(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %s: %w", filterStr, err)
+	}
+
+	prsr := &filterParser{tokens: tokens}
+	root, err := prsr.parseOr()
+	if err == nil && prsr.peek().kind != filterEnd {
+		err = fmt.Errorf("unexpected '%s' at position %d", prsr.peek().value, prsr.peek().pos)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %s: %w", filterStr, err)
+	}
+	return &filter{source: source, root: root}, nil
+}
+
+func (prsr *filterParser) peek() filterToken {
+	return prsr.tokens[prsr.pos]
+}
+
+func (prsr *filterParser) next() filterToken {
+	token := prsr.tokens[prsr.pos]
+	if token.kind != filterEnd {
+		prsr.pos++
+	}
+	return token
+}
+
+func (prsr *filterParser) parseOr() (filterExpression, error) {
+	left, err := prsr.parseAnd()
+	for err == nil && prsr.peek().kind == filterOperator && prsr.peek().value == "||" {
+		prsr.next()
+		var right filterExpression
+		if right, err = prsr.parseAnd(); err == nil {
+			left = &filterLogical{op: "||", left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (prsr *filterParser) parseAnd() (filterExpression, error) {
+	left, err := prsr.parseUnary()
+	for err == nil && prsr.peek().kind == filterOperator && prsr.peek().value == "&&" {
+		prsr.next()
+		var right filterExpression
+		if right, err = prsr.parseUnary(); err == nil {
+			left = &filterLogical{op: "&&", left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (prsr *filterParser) parseUnary() (filterExpression, error) {
+	if token := prsr.peek(); token.kind == filterOperator && token.value == "!" {
+		prsr.next()
+		operand, err := prsr.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &filterNot{operand: operand}, nil
+	}
+
+	if prsr.peek().kind == filterOpenParen {
+		prsr.next()
+		expr, err := prsr.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if token := prsr.next(); token.kind != filterCloseParen {
+			return nil, fmt.Errorf("expected ')' at position %d", token.pos)
+		}
+		return expr, nil
+	}
+	return prsr.parseComparison()
+}
+
+func (prsr *filterParser) parseComparison() (filterExpression, error) {
+	left, err := prsr.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	token := prsr.peek()
+	switch token.value {
+	case "==", "!=", "<", "<=", ">", ">=":
+		if token.kind != filterOperator {
+			break
+		}
+		prsr.next()
+
+		right, err := prsr.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &filterComparison{op: token.value, left: left, right: right}, nil
+	}
+
+	if _, isPath := left.(*filterPath); !isPath {
+		return nil, fmt.Errorf("expected a comparison at position %d", token.pos)
+	}
+	return left, nil
+}
+
+func (prsr *filterParser) parseOperand() (filterExpression, error) {
+	token := prsr.next()
+	switch token.kind {
+	case filterAt:
+		relPath := &filterPath{}
+		for prsr.peek().kind == filterDot {
+			prsr.next()
+			step := prsr.next()
+			switch step.kind {
+			case filterKey:
+				relPath.steps = append(relPath.steps, step.value)
+			case filterIndex:
+				idx, err := strconv.Atoi(step.value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index '%s' at position %d", step.value, step.pos)
+				}
+				relPath.steps = append(relPath.steps, idx)
+			default:
+				return nil, fmt.Errorf("expected a quoted key or an index at position %d", step.pos)
+			}
+		}
+		return relPath, nil
+	case filterString:
+		return &filterLiteral{value: token.value}, nil
+	case filterNumberLiteral:
+		num, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s' at position %d", token.value, token.pos)
+		}
+		return &filterLiteral{value: num}, nil
+	case filterWord:
+		switch token.value {
+		case "true":
+			return &filterLiteral{value: true}, nil
+		case "false":
+			return &filterLiteral{value: false}, nil
+		case "null":
+			return &filterLiteral{value: nil}, nil
+		}
+		return nil, fmt.Errorf("unknown literal '%s' at position %d", token.value, token.pos)
+	case filterEnd:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected '%s' at position %d", token.value, token.pos)
+}
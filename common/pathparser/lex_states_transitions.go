@@ -25,6 +25,10 @@ const (
 	INDEX
 	QUOTE
 	DELIMITER
+	ARGUMENTS_START
+	ARGUMENTS_END
+	WILDCARD
+	SUBSCRIPT
 	EOL
 )
 
@@ -34,6 +38,19 @@ const (
 	NONE_FN         = "none"
 	ANY_FN          = "any"
 	EACH_FN         = "each"
+	COUNT_FN        = "count"
+	SUM_FN          = "sum"
+	MIN_FN          = "min"
+	MAX_FN          = "max"
+	AVG_FN          = "avg"
+	KEYS_FN         = "keys"
+	VALUES_FN       = "values"
+	ENTRIES_FN      = "entries"
+	FROM_JSON_FN    = "fromJson"
+	FROM_YAML_FN    = "fromYaml"
+	FROM_BASE64_FN  = "fromBase64"
+	PARSE_URL_FN    = "parseUrl"
+	SPLIT_FN        = "split"
 )
 
 type lexStateAcceptFn func(l *lexer) bool
@@ -41,8 +58,8 @@ type lexStateTransitionFn func(l *lexer) tokenType
 
 type lexStateTransitionMap map[tokenType]*lexState
 
-func isIdentifierCharacter(ch *byte) bool {
-	return *ch != '\''
+func isIdentifierCharacter(ch *byte, quote byte) bool {
+	return *ch != quote
 }
 
 func isKeywordCharacter(ch *byte) bool {
@@ -57,13 +74,42 @@ func isIndexCharacter(ch *byte) bool {
 	return *ch >= '0' && *ch <= '9'
 }
 
-var keywordsExp = `\b(attributes|length|each|any|none)\b`
+func isIndexStartCharacter(ch *byte) bool {
+	return isIndexCharacter(ch) || *ch == '-'
+}
+
+var keywordsExp = `\b(attributes|length|each|any|none|count|sum|min|max|avg|keys|values|entries|fromJson|fromYaml|fromBase64|parseUrl|split)\b`
 var keywordsRegexp = regexp.MustCompile(keywordsExp)
 
 func isKeyword(s string) bool {
 	return keywordsRegexp.MatchString(s)
 }
 
+// isSubscriptComplete tells whether the scanned subscript has its opening bracket closed,
+// ignoring brackets within quoted keys and strings.
+func isSubscriptComplete(scanned []byte) bool {
+	depth := 0
+	var quote byte
+	for _, ch := range scanned {
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == '[':
+			depth++
+		case ch == ']':
+			depth--
+			if depth == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func lexStateTransitions() *lexStateTransitionMap {
 	return &lexStateTransitionMap{
 		OBJECT_IDENTIFIER_START: &lexState{
@@ -98,6 +144,10 @@ func lexStateTransitions() *lexStateTransitionMap {
 				if *ch == '.' {
 					return DELIMITER
 				}
+
+				if *ch == '[' {
+					return SUBSCRIPT
+				}
 				return UNEXPECTED
 			},
 		},
@@ -117,7 +167,7 @@ func lexStateTransitions() *lexStateTransitionMap {
 					return QUOTE
 				}
 
-				if isIndexCharacter(ch) {
+				if isIndexStartCharacter(ch) {
 					return INDEX
 				}
 
@@ -125,6 +175,14 @@ func lexStateTransitions() *lexStateTransitionMap {
 					return KEYWORD
 				}
 
+				if *ch == '*' {
+					return WILDCARD
+				}
+
+				if *ch == '[' {
+					return SUBSCRIPT
+				}
+
 				return UNEXPECTED
 			},
 		},
@@ -132,7 +190,7 @@ func lexStateTransitions() *lexStateTransitionMap {
 			state: QUOTE,
 			accepts: func(l *lexer) bool {
 				ch, err := l.peek()
-				return ch != nil && err == nil && *ch == '\''
+				return ch != nil && err == nil && *ch == l.quote()
 			},
 			nextState: func(l *lexer) tokenType {
 				ch, err := l.peek()
@@ -140,11 +198,25 @@ func lexStateTransitions() *lexStateTransitionMap {
 					return EOL
 				}
 
-				if *ch == '.' {
+				closingQuote := l.closingQuote
+				l.closingQuote = false
+				if closingQuote {
+					l.quoteChar = 0
+				}
+
+				if closingQuote && *ch == '.' {
 					return DELIMITER
 				}
 
-				if isIdentifierCharacter(ch) {
+				if closingQuote && l.inArguments && *ch == ')' {
+					return ARGUMENTS_END
+				}
+
+				if closingQuote && *ch == '[' {
+					return SUBSCRIPT
+				}
+
+				if isIdentifierCharacter(ch, l.quote()) {
 					return IDENTIFIER
 				}
 
@@ -155,7 +227,7 @@ func lexStateTransitions() *lexStateTransitionMap {
 			state: IDENTIFIER,
 			accepts: func(l *lexer) bool {
 				ch, err := l.peek()
-				return ch != nil && err == nil && isIdentifierCharacter(ch)
+				return ch != nil && err == nil && isIdentifierCharacter(ch, l.quote())
 			},
 			nextState: func(l *lexer) tokenType {
 				ch, err := l.peek()
@@ -163,7 +235,8 @@ func lexStateTransitions() *lexStateTransitionMap {
 					return EOL
 				}
 
-				if *ch == '\'' {
+				if *ch == l.quote() {
+					l.closingQuote = true
 					return QUOTE
 				}
 
@@ -178,7 +251,14 @@ func lexStateTransitions() *lexStateTransitionMap {
 			state: INDEX,
 			accepts: func(l *lexer) bool {
 				ch, err := l.peek()
-				return ch != nil && err == nil && isIndexCharacter(ch)
+				if ch == nil || err != nil {
+					return false
+				}
+
+				if len(l.scanBuf) == 0 {
+					return isIndexStartCharacter(ch)
+				}
+				return isIndexCharacter(ch)
 			},
 			nextState: func(l *lexer) tokenType {
 				ch, err := l.peek()
@@ -193,6 +273,61 @@ func lexStateTransitions() *lexStateTransitionMap {
 				if *ch == '.' {
 					return DELIMITER
 				}
+
+				if *ch == '[' {
+					return SUBSCRIPT
+				}
+				return UNEXPECTED
+			},
+		},
+		WILDCARD: &lexState{
+			state: WILDCARD,
+			accepts: func(l *lexer) bool {
+				ch, err := l.peek()
+				return ch != nil && err == nil && *ch == '*' && len(l.scanBuf) == 0
+			},
+			nextState: func(l *lexer) tokenType {
+				ch, err := l.peek()
+				if err != nil {
+					return EOL
+				}
+
+				if *ch == '.' {
+					return DELIMITER
+				}
+
+				if *ch == '[' {
+					return SUBSCRIPT
+				}
+				return UNEXPECTED
+			},
+		},
+		SUBSCRIPT: &lexState{
+			state: SUBSCRIPT,
+			accepts: func(l *lexer) bool {
+				ch, err := l.peek()
+				if ch == nil || err != nil {
+					return false
+				}
+
+				if len(l.scanBuf) == 0 {
+					return *ch == '['
+				}
+				return !isSubscriptComplete(l.scanBuf)
+			},
+			nextState: func(l *lexer) tokenType {
+				ch, err := l.peek()
+				if err != nil {
+					return EOL
+				}
+
+				if *ch == '.' {
+					return DELIMITER
+				}
+
+				if *ch == '[' {
+					return SUBSCRIPT
+				}
 				return UNEXPECTED
 			},
 		},
@@ -200,7 +335,10 @@ func lexStateTransitions() *lexStateTransitionMap {
 			state: KEYWORD,
 			accepts: func(l *lexer) bool {
 				ch, err := l.peek()
-				return ch != nil && err == nil && isKeywordCharacter(ch)
+				if ch == nil || err != nil {
+					return false
+				}
+				return isKeywordCharacter(ch) || len(l.scanBuf) > 0 && isIndexCharacter(ch)
 			},
 			nextState: func(l *lexer) tokenType {
 				ch, err := l.peek()
@@ -216,6 +354,63 @@ func lexStateTransitions() *lexStateTransitionMap {
 					return DELIMITER
 				}
 
+				if *ch == '(' {
+					return ARGUMENTS_START
+				}
+
+				if *ch == '[' {
+					return SUBSCRIPT
+				}
+
+				return UNEXPECTED
+			},
+		},
+		ARGUMENTS_START: &lexState{
+			state: ARGUMENTS_START,
+			accepts: func(l *lexer) bool {
+				ch, err := l.peek()
+				return ch != nil && err == nil && *ch == '(' && len(l.scanBuf) == 0
+			},
+			nextState: func(l *lexer) tokenType {
+				l.inArguments = true
+				ch, err := l.peek()
+				if err != nil {
+					return EOL
+				}
+
+				if *ch == '\'' || *ch == '"' {
+					l.quoteChar = *ch
+					return QUOTE
+				}
+
+				if *ch == ')' {
+					return ARGUMENTS_END
+				}
+
+				return UNEXPECTED
+			},
+		},
+		ARGUMENTS_END: &lexState{
+			state: ARGUMENTS_END,
+			accepts: func(l *lexer) bool {
+				ch, err := l.peek()
+				return ch != nil && err == nil && *ch == ')' && len(l.scanBuf) == 0
+			},
+			nextState: func(l *lexer) tokenType {
+				l.inArguments = false
+				ch, err := l.peek()
+				if err != nil {
+					return EOL
+				}
+
+				if *ch == '.' {
+					return DELIMITER
+				}
+
+				if *ch == '[' {
+					return SUBSCRIPT
+				}
+
 				return UNEXPECTED
 			},
 		},
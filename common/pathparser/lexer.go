@@ -25,6 +25,9 @@ type lexer struct {
 	lastPos             int
 	tokenItems          []tokenItem
 	lexStateTransitions *lexStateTransitionMap
+	inArguments         bool
+	closingQuote        bool
+	quoteChar           byte
 }
 
 type lexState struct {
@@ -37,6 +40,15 @@ func (l *lexer) skip() {
 	l.pos++
 }
 
+// quote returns the quote character enclosing the current identifier.
+// Arguments of functions can be enclosed in double quotes, keys only in single quotes.
+func (l *lexer) quote() byte {
+	if l.quoteChar == 0 {
+		return '\''
+	}
+	return l.quoteChar
+}
+
 func (l *lexer) accepts() bool {
 	return l.currentState.accepts(l)
 }
@@ -55,6 +67,13 @@ func (l *lexer) NextItem() *tokenItem {
 	return &item
 }
 
+func (l *lexer) PeekItem() *tokenItem {
+	if len(l.tokenItems) == 0 {
+		return nil
+	}
+	return &l.tokenItems[0]
+}
+
 func newLexer(input string) *lexer {
 	l := &lexer{input: input, lexStateTransitions: stateTransitions()}
 	l.currentState = (*l.lexStateTransitions)[OBJECT_IDENTIFIER_START]
@@ -94,7 +113,8 @@ func (l *lexer) run() {
 					l.add(PROPERTY)
 				case LENGTH_PROP:
 					l.add(PROPERTY)
-				case NONE_FN, ANY_FN, EACH_FN:
+				case NONE_FN, ANY_FN, EACH_FN, COUNT_FN, SUM_FN, MIN_FN, MAX_FN, AVG_FN, KEYS_FN, VALUES_FN, ENTRIES_FN,
+					FROM_JSON_FN, FROM_YAML_FN, FROM_BASE64_FN, PARSE_URL_FN, SPLIT_FN:
 					l.add(FUNCTION)
 				}
 			}
@@ -9,6 +9,10 @@ package pathparser
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/conformize/conformize/common/path"
 )
@@ -62,13 +66,64 @@ func (pParser *PathParser) Parse(pathStr string) (path.Steps, error) {
 			switch token.value {
 			case EACH_FN, NONE_FN, ANY_FN:
 				pathSteps.Add(path.FunctionStep(token.value))
+			case COUNT_FN, SUM_FN, MIN_FN, MAX_FN, AVG_FN:
+				keys, err := parseFunctionArguments(lexer, token, pathStr)
+				if err != nil {
+					return nil, err
+				}
+				pathSteps.Add(path.AggregateStep{Function: token.value, Keys: keys})
+			case KEYS_FN, VALUES_FN, ENTRIES_FN:
+				keys, err := parseFunctionArguments(lexer, token, pathStr)
+				if err != nil {
+					return nil, err
+				}
+
+				if len(keys) > 0 {
+					return nil, fmt.Errorf("function '%s' takes no arguments, at position %d in %s", token.value, token.startPos, pathStr)
+				}
+				pathSteps.Add(path.ProjectionStep(token.value))
+			case FROM_JSON_FN, FROM_YAML_FN, FROM_BASE64_FN, PARSE_URL_FN, SPLIT_FN:
+				args, err := parseFunctionArguments(lexer, token, pathStr)
+				if err != nil {
+					return nil, err
+				}
+
+				if expected := transformArgumentsCount(token.value); len(args) != expected {
+					return nil, fmt.Errorf("function '%s' takes %d argument(s), got %d, at position %d in %s", token.value, expected, len(args), token.startPos, pathStr)
+				}
+				pathSteps.Add(path.TransformStep{Function: token.value, Arguments: args})
 			default:
 				return nil, fmt.Errorf("unexpected function %s, at position %d in %s", token.value, token.startPos, pathStr)
 			}
 		case INDEX:
+			if token.value == "-" {
+				return nil, fmt.Errorf("expected digits after '-' at position %d in %s", token.startPos, pathStr)
+			}
 			pathSteps.Add(path.IndexStep(token.value))
-		case DELIMITER, QUOTE, OBJECT_IDENTIFIER_START:
+		case DELIMITER:
+			switch token.value {
+			case ".":
+				continue
+			case "..":
+				if next := lexer.PeekItem(); next == nil || next.itemType == EOL {
+					return nil, fmt.Errorf("expected step after '..' at position %d in %s", token.startPos, pathStr)
+				}
+				pathSteps.Add(path.RecursiveStep{})
+			default:
+				return nil, fmt.Errorf("unexpected token %s, at position %d in %s", token.value, token.startPos, pathStr)
+			}
+		case WILDCARD:
+			pathSteps.Add(path.WildcardStep{})
+		case SUBSCRIPT:
+			step, err := parseSubscript(token.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w, at position %d in %s", err, token.startPos, pathStr)
+			}
+			pathSteps.Add(step)
+		case QUOTE, OBJECT_IDENTIFIER_START:
 			continue
+		case ARGUMENTS_START, ARGUMENTS_END:
+			return nil, fmt.Errorf("unexpected token %s, at position %d in %s", token.value, token.startPos, pathStr)
 		case UNEXPECTED:
 			return nil, fmt.Errorf("unexpected token %s, at position %d in %s", token.value, token.startPos, pathStr)
 		case EOL:
@@ -77,3 +132,99 @@ func (pParser *PathParser) Parse(pathStr string) (path.Steps, error) {
 	}
 	return pathSteps, nil
 }
+
+var sliceRegexp = regexp.MustCompile(`^\[\s*(-?\d+)?\s*(:\s*(-?\d+)?\s*)?\]$`)
+
+// parseSubscript parses a step in brackets - a filter, e.g. [?(@.'enabled' == true)],
+// an index, e.g. [-1], or a slice, e.g. [1:3].
+func parseSubscript(subscript string) (path.PathStep, error) {
+	if strings.HasPrefix(subscript, "[?") {
+		expr, err := parseFilter(subscript)
+		if err != nil {
+			return nil, err
+		}
+		return path.FilterStep{Expression: expr}, nil
+	}
+
+	bounds := sliceRegexp.FindStringSubmatch(subscript)
+	if bounds == nil || len(bounds[1]) == 0 && len(bounds[2]) == 0 {
+		return nil, fmt.Errorf("expected a filter, an index or a slice, got %s", subscript)
+	}
+
+	if len(bounds[2]) == 0 {
+		return path.IndexStep(bounds[1]), nil
+	}
+
+	start, err := parseSliceBound(bounds[1], subscript)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := parseSliceBound(bounds[3], subscript)
+	if err != nil {
+		return nil, err
+	}
+	return path.SliceStep{Start: start, End: end}, nil
+}
+
+func parseSliceBound(bound string, subscript string) (*int, error) {
+	if len(bound) == 0 {
+		return nil, nil
+	}
+
+	num, err := strconv.Atoi(bound)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slice bound '%s' in %s", bound, subscript)
+	}
+	return &num, nil
+}
+
+func transformArgumentsCount(fn string) int {
+	if fn == SPLIT_FN {
+		return 1
+	}
+	return 0
+}
+
+// parseFunctionArguments parses the optional quoted arguments of a function, e.g. sum('resources'.'cpu').
+func parseFunctionArguments(lexer *lexer, fnToken *tokenItem, pathStr string) ([]string, error) {
+	if next := lexer.PeekItem(); next == nil || next.itemType != ARGUMENTS_START {
+		return nil, nil
+	}
+	lexer.NextItem()
+
+	keys := []string{}
+	expected := []tokenType{QUOTE, ARGUMENTS_END}
+	for {
+		next := lexer.NextItem()
+		if next == nil || next.itemType == EOL {
+			return nil, fmt.Errorf("expected ')' to close arguments of '%s' at position %d in %s", fnToken.value, fnToken.startPos, pathStr)
+		}
+
+		if next.itemType == UNEXPECTED {
+			return nil, fmt.Errorf("expected a quoted key in arguments of '%s' at position %d in %s", fnToken.value, next.startPos, pathStr)
+		}
+
+		if !slices.Contains(expected, next.itemType) {
+			return nil, fmt.Errorf("unexpected token %s in arguments of '%s' at position %d in %s", next.value, fnToken.value, next.startPos, pathStr)
+		}
+
+		switch next.itemType {
+		case ARGUMENTS_END:
+			return keys, nil
+		case QUOTE:
+			key := lexer.NextItem()
+			if key == nil || key.itemType != IDENTIFIER {
+				return nil, fmt.Errorf("expected key in arguments of '%s' at position %d in %s", fnToken.value, next.startPos, pathStr)
+			}
+
+			if closing := lexer.NextItem(); closing == nil || closing.itemType != QUOTE {
+				return nil, fmt.Errorf("expected closing quote after key '%s' in arguments of '%s' in %s", key.value, fnToken.value, pathStr)
+			}
+			keys = append(keys, key.value)
+			expected = []tokenType{DELIMITER, ARGUMENTS_END}
+		case DELIMITER:
+			expected = []tokenType{QUOTE}
+		}
+	}
+}
@@ -73,3 +73,291 @@ func TestPathParserWithIndex(t *testing.T) {
 		}
 	}
 }
+
+func TestPathParserWithAggregates(t *testing.T) {
+	var testCases = []struct {
+		pathStr  string
+		expected path.Steps
+	}{
+		{
+			pathStr:  "$app.'pools'.sum",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("pools"), path.AggregateStep{Function: "sum"}},
+		},
+		{
+			pathStr:  "$app.'pools'.count()",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("pools"), path.AggregateStep{Function: "count"}},
+		},
+		{
+			pathStr:  "$app.'pools'.max('size')",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("pools"), path.AggregateStep{Function: "max", Keys: []string{"size"}}},
+		},
+		{
+			pathStr:  "$app.'pools'.avg('resources'.'cpu')",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("pools"), path.AggregateStep{Function: "avg", Keys: []string{"resources", "cpu"}}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		pathParser := NewPathParser()
+		var steps, err = pathParser.Parse(testCase.pathStr)
+		if err != nil {
+			t.Errorf("Failed to parse path, reason: %s", err.Error())
+		}
+
+		if len(steps) != len(testCase.expected) {
+			t.Errorf("expected %d number of steps, got %d", len(testCase.expected), len(steps))
+		}
+
+		var expectedSteps = testCase.expected
+		for step, hasNext := steps.Next(); hasNext; step, hasNext = steps.Next() {
+			if expectedStep, _ := expectedSteps.Next(); !step.Equal(expectedStep) {
+				t.Errorf("expected %v, got %v", expectedStep, step)
+			}
+		}
+	}
+}
+
+func TestPathParserReturnsErrorWithMalformedAggregate(t *testing.T) {
+	for _, pathStr := range []string{
+		"$app.'pools'.sum(",
+		"$app.'pools'.sum('size'",
+		"$app.'pools'.sum(size)",
+		"$app.'pools'.each('size')",
+	} {
+		if _, err := NewPathParser().Parse(pathStr); err == nil {
+			t.Errorf("expected error parsing %s", pathStr)
+		}
+	}
+}
+
+func TestPathParserWithWildcardsAndRecursiveDescent(t *testing.T) {
+	var testCases = []struct {
+		pathStr  string
+		expected path.Steps
+	}{
+		{
+			pathStr:  "$app.'services'.*.'replicas'",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("services"), path.WildcardStep{}, path.KeyStep("replicas")},
+		},
+		{
+			pathStr:  "$app..'password'",
+			expected: path.Steps{path.ObjectStep("app"), path.RecursiveStep{}, path.KeyStep("password")},
+		},
+		{
+			pathStr:  "$app.'servers'..*.each",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("servers"), path.RecursiveStep{}, path.WildcardStep{}, path.FunctionStep("each")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var steps, err = NewPathParser().Parse(testCase.pathStr)
+		if err != nil {
+			t.Errorf("Failed to parse path, reason: %s", err.Error())
+		}
+
+		if len(steps) != len(testCase.expected) {
+			t.Errorf("expected %d number of steps, got %d", len(testCase.expected), len(steps))
+		}
+
+		var expectedSteps = testCase.expected
+		for step, hasNext := steps.Next(); hasNext; step, hasNext = steps.Next() {
+			if expectedStep, _ := expectedSteps.Next(); !step.Equal(expectedStep) {
+				t.Errorf("expected %v, got %v", expectedStep, step)
+			}
+		}
+	}
+}
+
+func TestPathParserWithFilters(t *testing.T) {
+	var testCases = []struct {
+		pathStr  string
+		element  any
+		expected bool
+	}{
+		{pathStr: "$app.'servers'[?(@.'enabled' == true)]", element: map[string]any{"enabled": true}, expected: true},
+		{pathStr: "$app.'servers'[?(@.'enabled' == true)]", element: map[string]any{"enabled": false}, expected: false},
+		{pathStr: "$app.'servers'[?(@.'port' >= 1024 && @.'port' < 65536)]", element: map[string]any{"port": 8080}, expected: true},
+		{pathStr: "$app.'servers'[?(@.'name' != 'a]b')]", element: map[string]any{"name": "a]b"}, expected: false},
+		{pathStr: "$app.'servers'[?(@.'tls')]", element: map[string]any{"tls": map[string]any{}}, expected: true},
+		{pathStr: "$app.'servers'[?(!@.'tls' || @.'tls'.'enabled' == false)]", element: map[string]any{"tls": map[string]any{"enabled": true}}, expected: false},
+		{pathStr: "$app.'servers'[?(@.'hosts'.0 == \"a\")]", element: map[string]any{"hosts": []any{"a", "b"}}, expected: true},
+		{pathStr: "$app.'servers'[?(@.'hosts'.-1 == 'b')]", element: map[string]any{"hosts": []any{"a", "b"}}, expected: true},
+		{pathStr: "$app.'servers'[?(@.'hosts'.-3 == 'a')]", element: map[string]any{"hosts": []any{"a", "b"}}, expected: false},
+		{pathStr: "$app.'servers'[?(@.'hosts'.2)]", element: map[string]any{"hosts": []any{"a", "b"}}, expected: false},
+		{pathStr: "$app.'servers'[?(@ > -1.5)]", element: 0, expected: true},
+		{pathStr: "$app.'servers'..[?(@.'enabled' == true)]", element: map[string]any{"enabled": true}, expected: true},
+	}
+
+	for _, testCase := range testCases {
+		steps, err := NewPathParser().Parse(testCase.pathStr)
+		if err != nil {
+			t.Fatalf("Failed to parse path %s, reason: %s", testCase.pathStr, err.Error())
+		}
+
+		if steps.String() != testCase.pathStr {
+			t.Errorf("expected path %s, got %s", testCase.pathStr, steps.String())
+		}
+
+		filterStep, ok := steps[len(steps)-1].(path.FilterStep)
+		if !ok {
+			t.Fatalf("expected a filter step in %s, got %v", testCase.pathStr, steps[len(steps)-1])
+		}
+
+		if matches := filterStep.Expression.Matches(testCase.element); matches != testCase.expected {
+			t.Errorf("expected %s to match %v: %t, got %t", testCase.pathStr, testCase.element, testCase.expected, matches)
+		}
+	}
+}
+
+func TestPathStepsStringStartingWithSubscript(t *testing.T) {
+	steps, err := NewPathParser().Parse("$app..[?(@ > 1)]")
+	if err != nil {
+		t.Fatalf("Failed to parse path, reason: %s", err.Error())
+	}
+
+	for _, testCase := range []struct {
+		steps    path.Steps
+		expected string
+	}{
+		{steps: steps[2:], expected: "[?(@ > 1)]"},
+		{steps: path.Steps{path.SliceStep{}}, expected: "[:]"},
+	} {
+		if pathStr := testCase.steps.String(); pathStr != testCase.expected {
+			t.Errorf("expected path %s, got %s", testCase.expected, pathStr)
+		}
+	}
+}
+
+func TestPathParserReturnsErrorWithMalformedSubscriptOrDescent(t *testing.T) {
+	for _, pathStr := range []string{
+		"$app..",
+		"$app...'password'",
+		"$app.'servers'[?(@.'enabled' ==)]",
+		"$app.'servers'[?(@.'enabled' == true]",
+		"$app.'servers'[(@.'enabled')]",
+		"$app.'servers'[?(@.enabled)]",
+		"$app.'servers'[?(@.'port' > 80 80)]",
+		"$app.'servers'[?('a')]",
+		"$app.'servers'[?(@.'name' == yes)]",
+		"$app.'servers'[]",
+		"$app.'servers'[1:2:3]",
+		"$app.'servers'[a]",
+		"$app.'servers'.-",
+		"$app.'headers'.keys('name')",
+	} {
+		if _, err := NewPathParser().Parse(pathStr); err == nil {
+			t.Errorf("expected error parsing %s", pathStr)
+		}
+	}
+}
+
+func TestPathParserWithSlicesAndProjections(t *testing.T) {
+	one, three, last := 1, 3, -1
+	var testCases = []struct {
+		pathStr  string
+		expected path.Steps
+	}{
+		{
+			pathStr:  "$app.'regions'[-1]",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("regions"), path.IndexStep("-1")},
+		},
+		{
+			pathStr:  "$app.'regions'.-1",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("regions"), path.IndexStep("-1")},
+		},
+		{
+			pathStr:  "$app.'regions'[1:3]",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("regions"), path.SliceStep{Start: &one, End: &three}},
+		},
+		{
+			pathStr:  "$app.'regions'[:-1].each",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("regions"), path.SliceStep{End: &last}, path.FunctionStep("each")},
+		},
+		{
+			pathStr:  "$app.'headers'.keys().each",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("headers"), path.ProjectionStep("keys"), path.FunctionStep("each")},
+		},
+		{
+			pathStr:  "$app.'headers'.entries[0].'key'",
+			expected: path.Steps{path.ObjectStep("app"), path.KeyStep("headers"), path.ProjectionStep("entries"), path.IndexStep("0"), path.KeyStep("key")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var steps, err = NewPathParser().Parse(testCase.pathStr)
+		if err != nil {
+			t.Errorf("Failed to parse path, reason: %s", err.Error())
+		}
+
+		if len(steps) != len(testCase.expected) {
+			t.Errorf("expected %d number of steps, got %d", len(testCase.expected), len(steps))
+		}
+
+		var expectedSteps = testCase.expected
+		for step, hasNext := steps.Next(); hasNext; step, hasNext = steps.Next() {
+			if expectedStep, _ := expectedSteps.Next(); !step.Equal(expectedStep) {
+				t.Errorf("expected %v, got %v", expectedStep, step)
+			}
+		}
+	}
+}
+
+func TestPathParserWithTransforms(t *testing.T) {
+	var testCases = []struct {
+		pathStr  string
+		expected path.Steps
+	}{
+		{
+			pathStr:  "$env.'APP_CONFIG'.fromJson().'db'.'host'",
+			expected: path.Steps{path.ObjectStep("env"), path.KeyStep("APP_CONFIG"), path.TransformStep{Function: "fromJson"}, path.KeyStep("db"), path.KeyStep("host")},
+		},
+		{
+			pathStr:  "$env.'SECRET'.fromBase64.fromYaml",
+			expected: path.Steps{path.ObjectStep("env"), path.KeyStep("SECRET"), path.TransformStep{Function: "fromBase64"}, path.TransformStep{Function: "fromYaml"}},
+		},
+		{
+			pathStr:  "$env.'DATABASE_URL'.parseUrl().'query'",
+			expected: path.Steps{path.ObjectStep("env"), path.KeyStep("DATABASE_URL"), path.TransformStep{Function: "parseUrl"}, path.KeyStep("query")},
+		},
+		{
+			pathStr:  "$env.'HOSTS'.split(\",\").each",
+			expected: path.Steps{path.ObjectStep("env"), path.KeyStep("HOSTS"), path.TransformStep{Function: "split", Arguments: []string{","}}, path.FunctionStep("each")},
+		},
+		{
+			pathStr:  "$env.'HOSTS'.split('.')[0]",
+			expected: path.Steps{path.ObjectStep("env"), path.KeyStep("HOSTS"), path.TransformStep{Function: "split", Arguments: []string{"."}}, path.IndexStep("0")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var steps, err = NewPathParser().Parse(testCase.pathStr)
+		if err != nil {
+			t.Errorf("Failed to parse path, reason: %s", err.Error())
+		}
+
+		if len(steps) != len(testCase.expected) {
+			t.Errorf("expected %d number of steps, got %d", len(testCase.expected), len(steps))
+		}
+
+		var expectedSteps = testCase.expected
+		for step, hasNext := steps.Next(); hasNext; step, hasNext = steps.Next() {
+			if expectedStep, _ := expectedSteps.Next(); !step.Equal(expectedStep) {
+				t.Errorf("expected %v, got %v", expectedStep, step)
+			}
+		}
+	}
+}
+
+func TestPathParserReturnsErrorWithMalformedTransform(t *testing.T) {
+	for _, pathStr := range []string{
+		"$env.'HOSTS'.split",
+		"$env.'HOSTS'.split()",
+		"$env.'HOSTS'.split(',",
+		"$env.'HOSTS'.split(\",')",
+		"$env.'CONFIG'.fromJson('db')",
+	} {
+		if _, err := NewPathParser().Parse(pathStr); err == nil {
+			t.Errorf("expected error parsing %s", pathStr)
+		}
+	}
+}
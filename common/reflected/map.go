@@ -27,10 +27,14 @@ func Map(val reflect.Value, targetType typed.Typeable) (typed.Valuable, error) {
 		elements := make(map[string]typed.Valuable)
 
 		iter := val.MapRange()
+		var key string
 		var v typed.Valuable
 		var err error
 		for iter.Next() {
-			key := iter.Key().String()
+			key, err = mapKey(iter.Key())
+			if err != nil {
+				return nil, err
+			}
 			v, err = Value(iter.Value(), elemType)
 			if err != nil {
 				return nil, err
@@ -41,3 +45,26 @@ func Map(val reflect.Value, targetType typed.Typeable) (typed.Valuable, error) {
 	}
 	return nil, fmt.Errorf("invalid type: %s", targetType.Name())
 }
+
+// mapKey returns the string form of a map key, unwrapping interface keys
+// such as the ones produced when decoding YAML into map[any]any.
+func mapKey(key reflect.Value) (string, error) {
+	if key.Kind() == reflect.Interface {
+		if key.IsNil() {
+			return "", fmt.Errorf("map key cannot be nil")
+		}
+		key = key.Elem()
+	}
+
+	switch key.Kind() {
+	case reflect.String:
+		return key.String(), nil
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(key.Interface()), nil
+	default:
+		return "", fmt.Errorf("unsupported map key type: %s", key.Type())
+	}
+}
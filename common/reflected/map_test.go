@@ -50,6 +50,17 @@ func TestMapValue(t *testing.T) {
 				},
 			},
 		},
+		{
+			valueType: &typed.MapTyped{ElementsType: &typed.StringTyped{}},
+			value:     map[any]any{"order": "desc", 1: "one"},
+			expected: &typed.MapValue{
+				ElementsType: &typed.StringTyped{},
+				Elements: map[string]typed.Valuable{
+					"order": value(typed.NewStringValue("desc")),
+					"1":     value(typed.NewStringValue("one")),
+				},
+			},
+		},
 		{
 			valueType: &typed.MapTyped{ElementsType: &typed.NumberTyped{}},
 			value:     map[string]float64{"3.14": 2.71},
@@ -67,6 +78,25 @@ func TestMapValue(t *testing.T) {
 	}
 }
 
+func TestMapValueWithInvalidKeys(t *testing.T) {
+	testCases := []any{
+		map[any]any{nil: "nil"},
+		map[any]any{"order": "desc", [2]int{1, 2}: "array"},
+		map[[2]int]string{{1, 2}: "array"},
+	}
+
+	for _, tc := range testCases {
+		if _, err := Map(reflect.ValueOf(tc), &typed.MapTyped{ElementsType: &typed.StringTyped{}}); err == nil {
+			t.Errorf("Map(%v) expected error for invalid key", tc)
+		}
+
+		val := reflect.ValueOf(tc)
+		if _, err := ValueFromTypeHint(val, typed.TypeHintOf(val)); err == nil {
+			t.Errorf("ValueFromTypeHint(%v) expected error for invalid key", tc)
+		}
+	}
+}
+
 func TestMapValueToMapWithStringValues(t *testing.T) {
 	var m map[string]string
 	var mapValue typed.Valuable = &typed.MapValue{
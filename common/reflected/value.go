@@ -86,7 +86,11 @@ func ValueFromTypeHint(val reflect.Value, targetTypeHint typed.TypeHinter) (type
 		var reflectElemVal reflect.Value
 		for idx < length {
 			reflectElemVal = val.Index(idx)
-			elemVal, err = Value(reflectElemVal, elemType)
+			if typed.IsPrimitive(elemTypeHint) {
+				elemVal, err = Value(reflectElemVal, elemType)
+			} else {
+				elemVal, err = ValueFromTypeHint(reflectElemVal, typed.TypeHintOf(reflectElemVal))
+			}
 			if err != nil {
 				return nil, fmt.Errorf("could not reflect element value")
 			}
@@ -99,8 +103,9 @@ func ValueFromTypeHint(val reflect.Value, targetTypeHint typed.TypeHinter) (type
 		elements := make(map[string]typed.Valuable, len(keys))
 		var elemType typed.Typeable
 		for _, key := range keys {
-			if key.Kind() != reflect.String {
-				continue
+			keyStr, err := mapKey(key)
+			if err != nil {
+				return nil, fmt.Errorf("could not reflect map key: %v", err)
 			}
 			elemVal := val.MapIndex(key)
 			elemTypeHint := typed.TypeHintOf(elemVal)
@@ -108,7 +113,7 @@ func ValueFromTypeHint(val reflect.Value, targetTypeHint typed.TypeHinter) (type
 			if err != nil {
 				return nil, fmt.Errorf("could not reflect map element value: %v", err)
 			}
-			elements[key.String()] = elemTypedVal
+			elements[keyStr] = elemTypedVal
 			if elemType == nil {
 				elemType = elemTypedVal.Type()
 			}
@@ -120,8 +125,9 @@ func ValueFromTypeHint(val reflect.Value, targetTypeHint typed.TypeHinter) (type
 		switch val.Kind() {
 		case reflect.Map:
 			for _, key := range val.MapKeys() {
-				if key.Kind() != reflect.String {
-					continue
+				keyStr, err := mapKey(key)
+				if err != nil {
+					return nil, fmt.Errorf("could not reflect object field name: %v", err)
 				}
 				fieldVal := val.MapIndex(key)
 				fieldHint := typed.TypeHintOf(fieldVal)
@@ -129,8 +135,8 @@ func ValueFromTypeHint(val reflect.Value, targetTypeHint typed.TypeHinter) (type
 				if err != nil {
 					return nil, fmt.Errorf("could not reflect object field value: %v", err)
 				}
-				fields[key.String()] = fieldTypedVal.Type()
-				values[key.String()] = fieldTypedVal
+				fields[keyStr] = fieldTypedVal.Type()
+				values[keyStr] = fieldTypedVal
 			}
 		default:
 			return nil, fmt.Errorf("cannot guess object fields from value of type %v", val.Type())
@@ -0,0 +1,36 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package functions
+
+import (
+	"sort"
+
+	"github.com/conformize/conformize/common/typed"
+)
+
+// FieldsOf returns the fields of an object or the elements of a map.
+func FieldsOf(value typed.Valuable) (map[string]typed.Valuable, bool) {
+	switch v := value.(type) {
+	case *typed.ObjectValue:
+		return v.Fields, true
+	case *typed.MapValue:
+		return v.Elements, true
+	default:
+		return nil, false
+	}
+}
+
+// SortedKeys returns the keys of fields in ascending order.
+func SortedKeys(fields map[string]typed.Valuable) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package functions
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+)
+
+func TestFieldsOf(t *testing.T) {
+	name, _ := typed.NewStringValue("web")
+	fields := map[string]typed.Valuable{"name": name}
+	tests := []struct {
+		name   string
+		value  typed.Valuable
+		want   map[string]typed.Valuable
+		wantOk bool
+	}{
+		{name: "returns object fields", value: &typed.ObjectValue{Fields: fields}, want: fields, wantOk: true},
+		{name: "returns map elements", value: &typed.MapValue{Elements: fields}, want: fields, wantOk: true},
+		{name: "rejects lists", value: &typed.ListValue{}, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FieldsOf(tt.value)
+			if ok != tt.wantOk || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FieldsOf() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	fields := map[string]typed.Valuable{"b": nil, "c": nil, "a": nil}
+	if got := SortedKeys(fields); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("SortedKeys() = %v, want [a b c]", got)
+	}
+}
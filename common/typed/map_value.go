@@ -54,7 +54,7 @@ func (mv *MapValue) String() string {
 	return "MapValue"
 }
 
-func (mv *MapValue) Lenght() int {
+func (mv *MapValue) Length() int {
 	return len(mv.Elements)
 }
 
@@ -0,0 +1,52 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package typed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeDescription describes a type in a human readable form, e.g. list(string) or string | number.
+func TypeDescription(typ Typeable) string {
+	switch t := typ.(type) {
+	case nil, *GenericTyped:
+		return "any"
+	case *ListTyped:
+		return fmt.Sprintf("list(%s)", TypeDescription(t.ElementsType))
+	case *MapTyped:
+		return fmt.Sprintf("map(%s)", TypeDescription(t.ElementsType))
+	case *TupleTyped:
+		elements := make([]string, 0, len(t.ElementsTypes))
+		for _, elementType := range t.ElementsTypes {
+			elements = append(elements, TypeDescription(elementType))
+		}
+		return fmt.Sprintf("tuple(%s)", strings.Join(elements, ", "))
+	case *ObjectTyped:
+		fieldNames := make([]string, 0, len(t.FieldsTypes))
+		for fieldName := range t.FieldsTypes {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+
+		fields := make([]string, 0, len(fieldNames))
+		for _, fieldName := range fieldNames {
+			fields = append(fields, fmt.Sprintf("%s: %s", fieldName, TypeDescription(t.FieldsTypes[fieldName])))
+		}
+		return fmt.Sprintf("object(%s)", strings.Join(fields, ", "))
+	case *VariantTyped:
+		variants := make([]string, 0, len(t.VariantsTypes))
+		for _, variantType := range t.VariantsTypes {
+			variants = append(variants, TypeDescription(variantType))
+		}
+		return strings.Join(variants, " | ")
+	default:
+		return string(typ.Name())
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Day  = 24 * time.Hour
+	Week = 7 * Day
+	Year = 365 * Day
+)
+
+var calendarUnits = map[string]time.Duration{
+	"d": Day,
+	"w": Week,
+	"y": Year,
+}
+
+var calendarDurationExp = regexp.MustCompile(`^(\d+)([dwy])(.*)$`)
+
+// ParseDuration extends time.ParseDuration with day (d), week (w) and year (y) units,
+// e.g. "30d", "2w" or "1d12h".
+func ParseDuration(s string) (time.Duration, error) {
+	durationStr := strings.TrimSpace(s)
+	if len(durationStr) == 0 {
+		return 0, fmt.Errorf("duration cannot be empty")
+	}
+
+	negative := false
+	if durationStr[0] == '-' || durationStr[0] == '+' {
+		negative = durationStr[0] == '-'
+		durationStr = durationStr[1:]
+	}
+
+	var duration time.Duration
+	for len(durationStr) > 0 {
+		match := calendarDurationExp.FindStringSubmatch(durationStr)
+		if match == nil {
+			break
+		}
+
+		amount, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s': %w", s, err)
+		}
+		duration += time.Duration(amount) * calendarUnits[match[2]]
+		durationStr = match[3]
+	}
+
+	if len(durationStr) > 0 {
+		remainder, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s'", s)
+		}
+		duration += remainder
+	}
+
+	if negative {
+		return -duration, nil
+	}
+	return duration, nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package util
+
+// EditDistance computes the number of insertions, deletions, substitutions and transpositions
+// of adjacent characters needed to turn one string into another.
+func EditDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	beforePrev := make([]int, len(target)+1)
+	prev := make([]int, len(target)+1)
+	curr := make([]int, len(target)+1)
+	for idx := range prev {
+		prev[idx] = idx
+	}
+
+	for i := 1; i <= len(source); i++ {
+		curr[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+
+			if i > 1 && j > 1 && source[i-1] == target[j-2] && source[i-2] == target[j-1] {
+				curr[j] = min(curr[j], beforePrev[j-2]+1)
+			}
+		}
+		beforePrev, prev, curr = prev, curr, beforePrev
+	}
+	return prev[len(target)]
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package util
+
+import (
+	"path"
+	"strings"
+)
+
+// MatchGlob tells whether a slash separated path matches a glob pattern, where ** matches
+// any number of directories and the other segments are matched as by path.Match.
+func MatchGlob(pattern string, name string) bool {
+	return matchGlobSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchGlobSegments(pattern []string, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+
+	if pattern[0] == "**" {
+		for skipped := 0; skipped <= len(segments); skipped++ {
+			if matchGlobSegments(pattern[1:], segments[skipped:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+		return false
+	}
+	return matchGlobSegments(pattern[1:], segments[1:])
+}
+
+// MatchGlobDir tells whether paths under a slash separated directory may match a glob
+// pattern, so that directories which can't hold any match are skipped when walking.
+func MatchGlobDir(pattern string, dir string) bool {
+	return matchGlobDirSegments(strings.Split(pattern, "/"), strings.Split(dir, "/"))
+}
+
+func matchGlobDirSegments(pattern []string, segments []string) bool {
+	if len(pattern) == 0 {
+		return false
+	}
+
+	if len(segments) == 0 || pattern[0] == "**" {
+		return true
+	}
+
+	if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+		return false
+	}
+	return matchGlobDirSegments(pattern[1:], segments[1:])
+}
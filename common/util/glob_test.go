@@ -0,0 +1,54 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package util
+
+import "testing"
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "*.yaml", name: "app.yaml", want: true},
+		{pattern: "*.yaml", name: "config/app.yaml", want: false},
+		{pattern: "**/*.yaml", name: "app.yaml", want: true},
+		{pattern: "**/*.yaml", name: "config/prod/app.yaml", want: true},
+		{pattern: "config/**/app.yaml", name: "config/app.yaml", want: true},
+		{pattern: "config/*/app.yaml", name: "other/prod/app.yaml", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchGlob(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("MatchGlob(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMatchGlobDir(t *testing.T) {
+	tests := []struct {
+		pattern string
+		dir     string
+		want    bool
+	}{
+		{pattern: "*.yaml", dir: "config", want: false},
+		{pattern: "config/*.yaml", dir: "config", want: true},
+		{pattern: "config/*.yaml", dir: "other", want: false},
+		{pattern: "config/*.yaml", dir: "config/prod", want: false},
+		{pattern: "config/*/app.yaml", dir: "config/prod", want: true},
+		{pattern: "config/**/app.yaml", dir: "config/prod/eu", want: true},
+		{pattern: "**/*.yaml", dir: "node_modules/lib", want: true},
+		{pattern: "*/app.yaml", dir: "app.yaml", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := MatchGlobDir(tt.pattern, tt.dir); got != tt.want {
+			t.Errorf("MatchGlobDir(%q, %q) = %v, want %v", tt.pattern, tt.dir, got, tt.want)
+		}
+	}
+}
@@ -18,6 +18,8 @@ type Blueprint struct {
 	Version    float64                                 `json:"version" yaml:"version"`
 	Sources    map[string]elements.ConfigurationSource `json:"sources,omitempty" yaml:"sources"`
 	References map[string]string                       `json:"$refs,omitempty" yaml:"$refs"`
+	Plugins    map[string]elements.Plugin              `json:"plugins,omitempty" yaml:"plugins"`
+	Predicates map[string]elements.PredicateDefinition `json:"predicates,omitempty" yaml:"predicates"`
 	Ruleset    []elements.Rule                         `json:"ruleset,omitempty" yaml:"ruleset"`
 }
 
@@ -87,6 +89,30 @@ func (b Blueprint) MarshalYAML() (any, error) {
 		})
 	}
 
+	if len(b.Plugins) > 0 {
+		rootNode.Content = append(rootNode.Content, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: "plugins",
+		})
+		pluginsNode := &yaml.Node{}
+		if err := pluginsNode.Encode(b.Plugins); err != nil {
+			return nil, err
+		}
+		rootNode.Content = append(rootNode.Content, pluginsNode)
+	}
+
+	if len(b.Predicates) > 0 {
+		rootNode.Content = append(rootNode.Content, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: "predicates",
+		})
+		predicatesNode := &yaml.Node{}
+		if err := predicatesNode.Encode(b.Predicates); err != nil {
+			return nil, err
+		}
+		rootNode.Content = append(rootNode.Content, predicatesNode)
+	}
+
 	rootNode.Content = append(rootNode.Content, &yaml.Node{
 		Kind:  yaml.ScalarNode,
 		Value: "ruleset",
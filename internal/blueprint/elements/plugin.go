@@ -0,0 +1,14 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package elements
+
+type Plugin struct {
+	Path    string   `json:"path" yaml:"path"`
+	Args    []string `json:"args,omitempty" yaml:"args,omitempty"`
+	Timeout string   `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package elements
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var predicateOperators = []string{"allOf", "anyOf", "noneOf"}
+
+type PredicateCondition struct {
+	Predicate string
+	Arguments any
+}
+
+type PredicateDefinition struct {
+	Description string
+	Parameters  []string
+	Operator    string
+	Conditions  []PredicateCondition
+}
+
+func (prdDef *PredicateDefinition) UnmarshalJSON(data []byte) error {
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	return unmarshalPredicateDefinition(raw, prdDef)
+}
+
+func (prdDef *PredicateDefinition) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw map[string]any
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	return unmarshalPredicateDefinition(raw, prdDef)
+}
+
+func unmarshalPredicateDefinition(raw map[string]any, prdDef *PredicateDefinition) error {
+	for key, value := range raw {
+		switch key {
+		case "description":
+			description, ok := value.(string)
+			if !ok {
+				return fmt.Errorf(" \"description\" attribute is not valid, expected value to be a string")
+			}
+			prdDef.Description = description
+		case "parameters":
+			params, ok := value.([]any)
+			if !ok {
+				return fmt.Errorf(" \"parameters\" attribute is not valid, expected a list of parameter names")
+			}
+
+			for _, param := range params {
+				paramName, ok := param.(string)
+				if !ok {
+					return fmt.Errorf(" \"parameters\" attribute is not valid, expected parameter names to be strings")
+				}
+				prdDef.Parameters = append(prdDef.Parameters, paramName)
+			}
+		case "allOf", "anyOf", "noneOf":
+			if len(prdDef.Operator) > 0 {
+				return fmt.Errorf("only one of %v can be specified", predicateOperators)
+			}
+			prdDef.Operator = key
+
+			conditions, ok := value.([]any)
+			if !ok {
+				return fmt.Errorf(" \"%s\" attribute is not valid, expected a list of conditions", key)
+			}
+
+			for idx, rawCond := range conditions {
+				cond, err := unmarshalPredicateCondition(rawCond)
+				if err != nil {
+					return fmt.Errorf("condition %d in \"%s\" is not valid, %w", idx+1, key, err)
+				}
+				prdDef.Conditions = append(prdDef.Conditions, cond)
+			}
+		default:
+			return fmt.Errorf("unknown attribute \"%s\"", key)
+		}
+	}
+	return nil
+}
+
+func unmarshalPredicateCondition(rawCond any) (PredicateCondition, error) {
+	var cond PredicateCondition
+	if predicate, isName := rawCond.(string); isName && len(predicate) > 0 {
+		cond.Predicate = predicate
+		return cond, nil
+	}
+
+	condFields, err := unmarshalMap(rawCond)
+	if err != nil {
+		return cond, fmt.Errorf("expected a predicate with its arguments")
+	}
+
+	if len(condFields) != 1 {
+		return cond, fmt.Errorf("expected exactly one predicate, got %d", len(condFields))
+	}
+
+	for predicate, args := range condFields {
+		cond.Predicate = predicate
+		cond.Arguments = args
+	}
+	return cond, nil
+}
+
+func (prdDef PredicateDefinition) MarshalYAML() (any, error) {
+	return prdDef.raw(), nil
+}
+
+func (prdDef PredicateDefinition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(prdDef.raw())
+}
+
+func (prdDef PredicateDefinition) raw() map[string]any {
+	raw := make(map[string]any)
+	if len(prdDef.Description) > 0 {
+		raw["description"] = prdDef.Description
+	}
+
+	if len(prdDef.Parameters) > 0 {
+		raw["parameters"] = prdDef.Parameters
+	}
+
+	conditions := make([]map[string]any, 0, len(prdDef.Conditions))
+	for _, cond := range prdDef.Conditions {
+		conditions = append(conditions, map[string]any{cond.Predicate: cond.Arguments})
+	}
+	raw[prdDef.Operator] = conditions
+	return raw
+}
@@ -12,8 +12,12 @@ import (
 
 	"github.com/conformize/conformize/common/diagnostics"
 	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates"
 )
 
+// SensitivePlaceholder replaces sensitive values in rule reports.
+const SensitivePlaceholder = "<sensitive>"
+
 type ArgumentMeta struct {
 	Value     typed.RawValue
 	Sensitive bool
@@ -26,7 +30,7 @@ func (argMeta *ArgumentMeta) String() string {
 	}
 
 	if argMeta.Sensitive {
-		return "<sensitive>"
+		return SensitivePlaceholder
 	}
 
 	return fmt.Sprintf("%v", argMeta.Value)
@@ -37,6 +41,15 @@ type RuleMeta struct {
 	Provider      string
 	Predicate     string
 	ValuePath     string
+	Sensitive     bool
 	ArgumentsMeta *ArgumentMeta
+	Findings      []predicates.Finding
+	Reason        string
 	Diagnostics   *diagnostics.Diagnostics
 }
+
+// IsSensitive tells whether the rule's value or arguments are sensitive, in which case
+// findings and reasons may disclose them.
+func (ruleMeta *RuleMeta) IsSensitive() bool {
+	return ruleMeta.Sensitive || (ruleMeta.ArgumentsMeta != nil && ruleMeta.ArgumentsMeta.Sensitive)
+}
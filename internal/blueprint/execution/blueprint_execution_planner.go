@@ -18,6 +18,16 @@ func (blprntExecPlan *BlueprintExecutionPlanner) Plan(blueprint *blueprint.Bluep
 	plan := NewBlueprintExecutionPlan()
 	plan.AddPhase(NewBlueprintValidationPhase(blueprint))
 
+	predicatesRegistrationPhase := NewPredicatesRegistrationPhase()
+	for name, pluginConfig := range blueprint.Plugins {
+		predicatesRegistrationPhase.AddStep(NewPluginRegistrationStep(name, &pluginConfig))
+	}
+
+	for name, prdDef := range blueprint.Predicates {
+		predicatesRegistrationPhase.AddStep(NewPredicateDefinitionRegistrationStep(name, &prdDef))
+	}
+	plan.AddPhase(predicatesRegistrationPhase)
+
 	providersInitializationPhase := NewProvidersInitializationPhase()
 	providersConfigurationPhase := NewBlueprintProvidersConfigurationPhase()
 	readSourcesPhase := NewBlueprintReadSourcesPhase()
@@ -8,6 +8,9 @@
 package execution
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/conformize/conformize/common/diagnostics"
@@ -30,3 +33,70 @@ func TestBlueprintExecutorExecutesBlueprintSuccessfully(t *testing.T) {
 		}
 	}
 }
+
+const sensitiveState = `{
+  "format_version": "1.0",
+  "terraform_version": "1.9.5",
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_db_instance.main",
+          "mode": "managed",
+          "type": "aws_db_instance",
+          "name": "main",
+          "values": {"password": "hunter2-secret", "endpoint": "postgres://admin:hunter2-secret@db:5432/app"},
+          "sensitive_values": {"password": true, "endpoint": true}
+        }
+      ]
+    }
+  }
+}`
+
+func TestBlueprintExecutorRedactsSensitiveValuesInFailures(t *testing.T) {
+	workDir := t.TempDir()
+	statePath := filepath.Join(workDir, "state.json")
+	if err := os.WriteFile(statePath, []byte(sensitiveState), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	blueprintContent := `version: 1
+sources:
+  tf:
+    terraform:
+      config:
+        path: ` + statePath + `
+ruleset:
+  - $value: $tf.'aws_db_instance.main'.'values'.'password'
+    after: now
+  - $value: $tf.'aws_db_instance.main'.'values'
+    noPlaintextSecrets:
+`
+	blueprintPath := filepath.Join(workDir, "blueprint.cnfrm.yaml")
+	if err := os.WriteFile(blueprintPath, []byte(blueprintContent), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	BlueprintUnmarshaller := &blueprint.BlueprintUnmarshaller{Path: blueprintPath}
+	blueprint, err := BlueprintUnmarshaller.Unmarshal()
+	if err != nil {
+		t.Fatalf("Blueprint Unmarshalling failed: %v", err)
+	}
+
+	diags := diagnostics.NewDiagnostics()
+	blueprintExecutor := BlueprintExecutor{}
+	blueprintExecutor.Execute(blueprint, diags)
+
+	output := diags.Errors().String()
+	if !strings.Contains(output, "2 rule assertions failed.") {
+		t.Fatalf("expected both rules to fail, got:\n%s", output)
+	}
+
+	if strings.Contains(output, "hunter2-secret") {
+		t.Errorf("expected sensitive value to be redacted, got:\n%s", output)
+	}
+
+	if !strings.Contains(output, "'endpoint': <sensitive>") || !strings.Contains(output, "reason:     <sensitive>") {
+		t.Errorf("expected redacted reason and finding, got:\n%s", output)
+	}
+}
@@ -15,13 +15,16 @@ import (
 
 	"github.com/conformize/conformize/common"
 	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
 	"github.com/conformize/conformize/common/format"
 	"github.com/conformize/conformize/common/format/colors"
 	"github.com/conformize/conformize/common/functions"
 	"github.com/conformize/conformize/common/path"
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/internal/blueprint/elements"
-	"github.com/conformize/conformize/predicates/condition"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/valuereferencesstore"
+	"github.com/conformize/conformize/predicates"
 	"github.com/conformize/conformize/predicates/predicatefactory"
 )
 
@@ -119,8 +122,7 @@ func (phase *BlueprintRulesetEvaluationPhase) Execute(blprntExecCtx *BlueprintEx
 func evaluateRule(blprntExecCtx *BlueprintExecutionContext, rIdx int, r *elements.Rule) (bool, *elements.RuleMeta) {
 	ruleIdx := rIdx + 1
 	valuePathSteps := r.Value.Steps()
-	predicateCondition := condition.FromString(r.Predicate)
-	predicate, err := predicatefactory.Instance().Build(predicateCondition)
+	predicate, err := predicatefactory.Instance().BuildByName(r.Predicate)
 	diags := diagnostics.NewDiagnostics()
 
 	ruleMeta := &elements.RuleMeta{
@@ -174,7 +176,8 @@ func evaluateRule(blprntExecCtx *BlueprintExecutionContext, rIdx int, r *element
 				return false, ruleMeta
 			}
 			argMeta.Path = arg.Path.String()
-			argVal, err = functions.ParseRawValue(valNode.Value)
+			argMeta.Sensitive = argMeta.Sensitive || isSensitiveValue(valRefStore, arg.Path.Steps(), valNode)
+			argVal, err = functions.ParseRawValue(functions.NodeRawValue(valNode))
 			if err != nil {
 				blprntExecCtx.diags.
 					Append(diagnostics.Builder().Error().
@@ -197,11 +200,18 @@ func evaluateRule(blprntExecCtx *BlueprintExecutionContext, rIdx int, r *element
 			)
 		return false, ruleMeta
 	}
+	ruleMeta.Sensitive = isSensitiveValue(valRefStore, valuePathSteps, valNode)
 
 	fnNode, ok := valNode.Value.(*common.IterFnNodeValue)
 	if !ok {
+		if nodePredicate, isNodePredicate := predicate.(predicates.NodePredicate); isNodePredicate {
+			ok, err = nodePredicate.TestNode(valNode, argVal)
+			collectFindings(ruleMeta, predicate, err)
+			return ok, ruleMeta
+		}
+
 		var val typed.Valuable
-		val, err = functions.ParseRawValue(valNode.Value)
+		val, err = functions.ParseRawValue(functions.NodeRawValue(valNode))
 		if err != nil {
 			diags.
 				Append(diagnostics.Builder().Error().
@@ -211,12 +221,77 @@ func evaluateRule(blprntExecCtx *BlueprintExecutionContext, rIdx int, r *element
 			return false, ruleMeta
 		}
 		ok, err = predicate.Test(val, argVal)
+		collectFindings(ruleMeta, predicate, err)
 		return ok, ruleMeta
 	}
 	ok, err = fnNode.Fn(fnNode.Iter, predicate, argVal)
+	collectFindings(ruleMeta, predicate, err)
 	return ok, ruleMeta
 }
 
+// isSensitiveValue tells whether the value at a path is sensitive. Steps like functions,
+// wildcards, filters or list indexes build new nodes without the attributes of the nodes
+// they're computed from, so every node visited while resolving the path is checked too.
+func isSensitiveValue(valRefStore *valuereferencesstore.ValueReferencesStore, steps path.Steps, valNode *ds.Node[string, any]) bool {
+	if isSensitiveNode(valNode) {
+		return true
+	}
+
+	for idx := 1; idx < len(steps); idx++ {
+		node, err := valRefStore.GetAtPath(path.NewPath(steps[:idx]))
+		if err != nil {
+			continue
+		}
+
+		if attr, found := node.GetAttribute(sdk.SensitiveAttribute); found && attr.Value == true {
+			return true
+		}
+
+		switch steps[idx].(type) {
+		case path.ObjectStep, path.KeyStep, path.AttributeStep:
+		default:
+			if isSensitiveNode(node) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSensitiveNode tells whether a provider marked a node, or any value nested in it, as sensitive.
+func isSensitiveNode(node *ds.Node[string, any]) bool {
+	if attr, found := node.GetAttribute(sdk.SensitiveAttribute); found && attr.Value == true {
+		return true
+	}
+
+	for _, children := range node.Children() {
+		for _, child := range children {
+			if isSensitiveNode(child) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func collectFindings(ruleMeta *elements.RuleMeta, predicate predicates.Predicate, err error) {
+	if err != nil {
+		ruleMeta.Reason = err.Error()
+	}
+
+	findingsReporter, ok := predicate.(predicates.FindingsReporter)
+	if !ok {
+		return
+	}
+
+	for _, finding := range findingsReporter.Findings() {
+		if len(finding.Path) > 0 {
+			finding.Path = ruleMeta.ValuePath + "." + finding.Path
+		}
+		ruleMeta.Findings = append(ruleMeta.Findings, finding)
+	}
+}
+
 const bulletIndent = " "
 const labelWidth = 12
 
@@ -250,13 +325,29 @@ func ruleViolationErrorMessage(ruleMeta *elements.RuleMeta, ruleIdx int) string
 
 	writeLine("$value", ruleMeta.ValuePath)
 	writeLine("predicate", ruleMeta.Predicate)
-	if ruleMeta.ArgumentsMeta.Value != nil {
-		if args, ok := ruleMeta.ArgumentsMeta.Value.([]any); ok {
+	if ruleMeta.ArgumentsMeta != nil && ruleMeta.ArgumentsMeta.Value != nil {
+		if args, ok := ruleMeta.ArgumentsMeta.Value.([]any); ok && !ruleMeta.ArgumentsMeta.Sensitive {
 			writeLine("arguments", fmt.Sprintf("%v", args))
 		} else {
 			writeLine("argument", ruleMeta.ArgumentsMeta.String())
 		}
 	}
 
+	sensitive := ruleMeta.IsSensitive()
+	for _, finding := range ruleMeta.Findings {
+		if sensitive {
+			finding.Message = elements.SensitivePlaceholder
+		}
+		writeLine("finding", finding.String())
+	}
+
+	if len(ruleMeta.Reason) > 0 {
+		reason := ruleMeta.Reason
+		if sensitive {
+			reason = elements.SensitivePlaceholder
+		}
+		writeLine("reason", reason)
+	}
+
 	return msgBldr.String()
 }
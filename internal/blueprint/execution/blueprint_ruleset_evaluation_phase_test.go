@@ -0,0 +1,112 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package execution
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/internal/blueprint/elements"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/kubernetes"
+	"github.com/conformize/conformize/internal/valuereferencesstore"
+)
+
+func TestEvaluateRuleMarksSensitiveValues(t *testing.T) {
+	secret, _ := kubernetes.ResourceNode(map[string]any{
+		"kind":     "Secret",
+		"metadata": map[string]any{"name": "db"},
+		"data":     map[string]any{"password": "aHVudGVyMi1zZWNyZXQ="},
+	}, false)
+
+	resource := ds.NewNode[string, any]()
+	tags := resource.AddChild("tags")
+	tags.Value = []any{"prod", "db"}
+	tags.AddAttribute(sdk.SensitiveAttribute, true)
+
+	valRefStore := valuereferencesstore.NewValueReferencesStore()
+	if err := valRefStore.AddReference("secret", secret); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := valRefStore.AddReference("resource", resource); err != nil {
+		t.Fatal(err)
+	}
+	blprntExecCtx := &BlueprintExecutionContext{diags: diagnostics.NewDiagnostics(), valueReferencesStore: valRefStore}
+
+	tests := []struct {
+		name              string
+		rule              string
+		wantSensitive     bool
+		wantArgsSensitive bool
+	}{
+		{
+			name:          "marks value sensitive when the provider marked its node",
+			rule:          `{"$value": "$secret.'data'.'password'", "after": "now"}`,
+			wantSensitive: true,
+		},
+		{
+			name:          "marks value sensitive when a nested node is sensitive",
+			rule:          `{"$value": "$secret.'data'", "hasKeys": "password"}`,
+			wantSensitive: true,
+		},
+		{
+			name:          "marks value sensitive when it's computed from a sensitive node",
+			rule:          `{"$value": "$secret.'data'.'password'.length", "gt": 8}`,
+			wantSensitive: true,
+		},
+		{
+			name:          "marks value sensitive when it's matched by a recursive descent",
+			rule:          `{"$value": "$secret..'password'", "after": "now"}`,
+			wantSensitive: true,
+		},
+		{
+			name:          "marks value sensitive when it's an element of a sensitive list",
+			rule:          `{"$value": "$resource.'tags'[0]", "eq": "db"}`,
+			wantSensitive: true,
+		},
+		{
+			name:              "marks arguments sensitive when they're sliced from a sensitive list",
+			rule:              `{"$value": "$secret.'metadata'.'name'", "eq": "$resource.'tags'[0:1]"}`,
+			wantArgsSensitive: true,
+		},
+		{
+			name: "doesn't mark value without sensitive nodes",
+			rule: `{"$value": "$secret.'metadata'.'name'", "eq": "db"}`,
+		},
+		{
+			name:              "marks arguments sensitive when they resolve to a sensitive node",
+			rule:              `{"$value": "$secret.'metadata'.'name'", "eq": "$secret.'data'.'password'"}`,
+			wantArgsSensitive: true,
+		},
+	}
+
+	for idx, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rule elements.Rule
+			if err := json.Unmarshal([]byte(tt.rule), &rule); err != nil {
+				t.Fatal(err)
+			}
+
+			_, ruleMeta := evaluateRule(blprntExecCtx, idx, &rule)
+			if ruleMeta.Diagnostics.HasErrors() {
+				t.Fatalf("evaluateRule() errors = %v", ruleMeta.Diagnostics.Errors())
+			}
+
+			if ruleMeta.Sensitive != tt.wantSensitive {
+				t.Errorf("evaluateRule() sensitive value = %v, want %v", ruleMeta.Sensitive, tt.wantSensitive)
+			}
+
+			if ruleMeta.ArgumentsMeta.Sensitive != tt.wantArgsSensitive {
+				t.Errorf("evaluateRule() sensitive arguments = %v, want %v", ruleMeta.ArgumentsMeta.Sensitive, tt.wantArgsSensitive)
+			}
+		})
+	}
+}
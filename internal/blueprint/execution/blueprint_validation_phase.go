@@ -37,6 +37,14 @@ func NewBlueprintValidationPhase(blueprint *blueprint.Blueprint) *BlueprintValid
 				blueprint:  blueprint,
 				validation: &validation.BlueprintReferencesValidator{},
 			},
+			&BlueprintValidationStep{
+				blueprint:  blueprint,
+				validation: &validation.BlueprintPluginsValidator{},
+			},
+			&BlueprintValidationStep{
+				blueprint:  blueprint,
+				validation: &validation.BlueprintPredicatesValidator{},
+			},
 			&BlueprintValidationStep{
 				blueprint:  blueprint,
 				validation: &validation.BlueprintRulesValidator{},
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package execution
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/format"
+	"github.com/conformize/conformize/common/format/colors"
+	"github.com/conformize/conformize/internal/blueprint/elements"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/plugin"
+	"github.com/conformize/conformize/predicates/predicatefactory"
+)
+
+type PluginRegistrationStep struct {
+	name   string
+	config *elements.Plugin
+}
+
+func NewPluginRegistrationStep(name string, config *elements.Plugin) *PluginRegistrationStep {
+	return &PluginRegistrationStep{
+		name:   name,
+		config: config,
+	}
+}
+
+func (step *PluginRegistrationStep) Run(blprntExecCtx *BlueprintExecutionContext) {
+	formatter := format.Formatter()
+
+	prdPlugin, err := plugin.New(step.name, step.config.Path, step.config.Args, step.config.Timeout)
+	if err == nil {
+		_, err = prdPlugin.Schema()
+	}
+
+	if err == nil {
+		err = predicatefactory.RegisterPredicate(step.name, func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+			return &plugin.PluginPredicate{Plugin: prdPlugin}
+		})
+	}
+
+	if err != nil {
+		line := formatter.
+			Detail(format.Failure).
+			Color(colors.Red).
+			Dimmed().
+			Format(fmt.Sprintf(" %-12s %-10s", step.name, "[ plugin ]"))
+
+		line += formatter.Color(colors.Red).Format(fmt.Sprintf("error: %s", err.Error()))
+		blprntExecCtx.diags.Append(diagnostics.Builder().Error().Summary(line).Build())
+		return
+	}
+
+	line := formatter.
+		Color(colors.Green).
+		Detail(format.Item).
+		Format("")
+
+	line += formatter.
+		Bold().
+		Format(fmt.Sprintf(" %-12s %-10s", step.name, "[ plugin ]"))
+
+	blprntExecCtx.diags.Append(diagnostics.Builder().Info().Summary(line).Build())
+}
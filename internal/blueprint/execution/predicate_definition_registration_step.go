@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package execution
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/internal/blueprint/elements"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/macro"
+	"github.com/conformize/conformize/predicates/predicatefactory"
+)
+
+type PredicateDefinitionRegistrationStep struct {
+	name       string
+	definition *elements.PredicateDefinition
+}
+
+func NewPredicateDefinitionRegistrationStep(name string, definition *elements.PredicateDefinition) *PredicateDefinitionRegistrationStep {
+	return &PredicateDefinitionRegistrationStep{
+		name:       name,
+		definition: definition,
+	}
+}
+
+func (step *PredicateDefinitionRegistrationStep) Run(blprntExecCtx *BlueprintExecutionContext) {
+	conditions := make([]macro.Condition, 0, len(step.definition.Conditions))
+	for _, cond := range step.definition.Conditions {
+		conditions = append(conditions, macro.Condition{Predicate: cond.Predicate, Arguments: cond.Arguments})
+	}
+
+	name, definition := step.name, step.definition
+	err := predicatefactory.RegisterPredicate(name, func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &macro.MacroPredicate{
+			Name:             name,
+			Description:      definition.Description,
+			Parameters:       definition.Parameters,
+			Operator:         macro.Operator(definition.Operator),
+			Conditions:       conditions,
+			PredicateBuilder: prdBuilder,
+		}
+	})
+
+	if err != nil {
+		blprntExecCtx.diags.Append(diagnostics.Builder().
+			Error().
+			Details(fmt.Sprintf("\nCouldn't register predicate '%s', reason:\n%s", name, err.Error())).
+			Build(),
+		)
+	}
+}
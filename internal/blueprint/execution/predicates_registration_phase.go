@@ -0,0 +1,28 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package execution
+
+type PredicatesRegistrationPhase struct {
+	steps []ExecutionStep
+}
+
+func (phase *PredicatesRegistrationPhase) AddStep(step ExecutionStep) {
+	phase.steps = append(phase.steps, step)
+}
+
+func (phase *PredicatesRegistrationPhase) Execute(blprntExecCtx *BlueprintExecutionContext) {
+	for _, step := range phase.steps {
+		step.Run(blprntExecCtx)
+	}
+}
+
+func NewPredicatesRegistrationPhase() *PredicatesRegistrationPhase {
+	return &PredicatesRegistrationPhase{
+		steps: make([]ExecutionStep, 0, 10),
+	}
+}
@@ -16,6 +16,8 @@ var validators = []BlueprintValidator{
 	&BlueprintVersionValidator{},
 	&BlueprintSourcesValidator{},
 	&BlueprintReferencesValidator{},
+	&BlueprintPluginsValidator{},
+	&BlueprintPredicatesValidator{},
 	&BlueprintRulesValidator{},
 }
 
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/internal/blueprint"
+	"github.com/conformize/conformize/predicates/condition"
+	"github.com/conformize/conformize/predicates/predicate/plugin"
+)
+
+type BlueprintPluginsValidator struct{}
+
+func (blprntPluginsVld *BlueprintPluginsValidator) Validate(blueprint *blueprint.Blueprint) *diagnostics.Diagnostics {
+	diags := diagnostics.NewDiagnostics()
+	names := make([]string, 0, len(blueprint.Plugins))
+	for name := range blueprint.Plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		pluginConfig := blueprint.Plugins[name]
+		if condition.FromString(name) != condition.UNKNOWN {
+			diags.Append(diagnostics.Builder().
+				Error().
+				Summary(fmt.Sprintf("\nPlugin '%s' clashes with a built-in predicate", name)).
+				Build(),
+			)
+			continue
+		}
+
+		prdPlugin, err := plugin.New(name, pluginConfig.Path, pluginConfig.Args, pluginConfig.Timeout)
+		if err == nil {
+			_, err = prdPlugin.Schema()
+		}
+
+		if err != nil {
+			diags.Append(diagnostics.Builder().
+				Error().
+				Summary(fmt.Sprintf("\nPlugin '%s' is not valid, reason:\n", name)).
+				Details(err.Error()).
+				Build(),
+			)
+		}
+	}
+	return diags
+}
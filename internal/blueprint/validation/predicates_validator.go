@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/internal/blueprint"
+	"github.com/conformize/conformize/internal/blueprint/elements"
+	"github.com/conformize/conformize/predicates/condition"
+	"github.com/conformize/conformize/predicates/predicate/macro"
+)
+
+var parameterNameExp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type BlueprintPredicatesValidator struct{}
+
+func (blprntPrdsVld *BlueprintPredicatesValidator) Validate(blueprint *blueprint.Blueprint) *diagnostics.Diagnostics {
+	diags := diagnostics.NewDiagnostics()
+
+	names := make([]string, 0, len(blueprint.Predicates))
+	for name := range blueprint.Predicates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prdDef := blueprint.Predicates[name]
+		if err := validatePredicateDefinition(name, &prdDef, blueprint); err != nil {
+			diags.Append(diagnostics.Builder().
+				Error().
+				Summary(fmt.Sprintf("\nPredicate '%s' is not valid, reason:\n", name)).
+				Details(err.Error()).
+				Build(),
+			)
+		}
+	}
+
+	for _, name := range names {
+		if cycle := predicateCycle(name, blueprint.Predicates, nil); cycle != nil && cycle[0] == name {
+			diags.Append(diagnostics.Builder().
+				Error().
+				Summary(fmt.Sprintf("\nPredicate '%s' is not valid, reason:\n", name)).
+				Details(fmt.Sprintf("predicate references itself: %s", strings.Join(cycle, " -> "))).
+				Build(),
+			)
+		}
+	}
+	return diags
+}
+
+func validatePredicateDefinition(name string, prdDef *elements.PredicateDefinition, blueprint *blueprint.Blueprint) error {
+	if condition.FromString(name) != condition.UNKNOWN {
+		return fmt.Errorf("name clashes with a built-in predicate")
+	}
+
+	if _, isPlugin := blueprint.Plugins[name]; isPlugin {
+		return fmt.Errorf("name clashes with plugin '%s'", name)
+	}
+
+	if len(prdDef.Conditions) == 0 {
+		return fmt.Errorf("no conditions specified, expected allOf, anyOf or noneOf with a list of conditions")
+	}
+
+	declared := make(map[string]bool, len(prdDef.Parameters))
+	for _, param := range prdDef.Parameters {
+		if !parameterNameExp.MatchString(param) {
+			return fmt.Errorf("parameter name '%s' is not valid", param)
+		}
+
+		if _, found := declared[param]; found {
+			return fmt.Errorf("parameter '%s' is declared more than once", param)
+		}
+		declared[param] = false
+	}
+
+	for idx, cond := range prdDef.Conditions {
+		if !predicateResolvable(cond.Predicate, blueprint) {
+			return fmt.Errorf("condition %d: couldn't resolve predicate '%s'", idx+1, cond.Predicate)
+		}
+
+		placeholders := macro.Placeholders(cond.Arguments)
+		for _, param := range placeholders {
+			if _, found := declared[param]; !found {
+				return fmt.Errorf("condition %d: parameter '%s' is not declared", idx+1, param)
+			}
+			declared[param] = true
+		}
+
+		if condSchema := predicateSchema(cond.Predicate, blueprint); condSchema != nil && len(placeholders) == 0 {
+			if err := checkRawArguments(cond.Arguments, condSchema); err != nil {
+				return fmt.Errorf("condition %d: predicate '%s': %w", idx+1, cond.Predicate, err)
+			}
+		}
+
+		if condPrdDef, isDefined := blueprint.Predicates[cond.Predicate]; isDefined && len(placeholders) == 0 {
+			if _, err := macro.BindArguments(condPrdDef.Parameters, cond.Arguments); err != nil {
+				return fmt.Errorf("condition %d: arguments don't match parameters of predicate '%s': %w", idx+1, cond.Predicate, err)
+			}
+		}
+	}
+
+	for _, param := range prdDef.Parameters {
+		if !declared[param] {
+			return fmt.Errorf("parameter '%s' is declared but not used", param)
+		}
+	}
+	return nil
+}
+
+func predicateResolvable(name string, blueprint *blueprint.Blueprint) bool {
+	if condition.FromString(name) != condition.UNKNOWN {
+		return true
+	}
+
+	if _, isPlugin := blueprint.Plugins[name]; isPlugin {
+		return true
+	}
+
+	_, isDefined := blueprint.Predicates[name]
+	return isDefined
+}
+
+// predicateCycle returns the chain of predicates leading back to name, if any.
+func predicateCycle(name string, prdDefs map[string]elements.PredicateDefinition, chain []string) []string {
+	for _, visited := range chain {
+		if visited == name {
+			return append(chain, name)
+		}
+	}
+
+	prdDef, found := prdDefs[name]
+	if !found {
+		return nil
+	}
+
+	chain = append(chain, name)
+	for _, cond := range prdDef.Conditions {
+		if cycle := predicateCycle(cond.Predicate, prdDefs, chain); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
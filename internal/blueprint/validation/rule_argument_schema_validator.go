@@ -0,0 +1,251 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/blueprint"
+	"github.com/conformize/conformize/internal/blueprint/elements"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/predicates/condition"
+	"github.com/conformize/conformize/predicates/predicate/plugin"
+	"github.com/conformize/conformize/predicates/predicatefactory"
+)
+
+type ruleArgumentSchemaValidator struct{}
+
+// Validate checks raw arguments of a rule against the arguments attribute of its predicate's schema.
+// Arguments referring to paths are resolved only when the blueprint is applied and are not checked.
+func (argSchemaValidator *ruleArgumentSchemaValidator) Validate(args elements.Value, prdSchema schema.Schemable) error {
+	if prdSchema == nil {
+		return nil
+	}
+
+	var rawArgs any
+	switch argVal := args.(type) {
+	case nil:
+	case *elements.RawValue:
+		rawArgs = argVal.Value
+	default:
+		return nil
+	}
+	return checkRawArguments(rawArgs, prdSchema)
+}
+
+// predicateSchema returns the schema of a built-in or plugin predicate, or nil if it can't be determined.
+func predicateSchema(name string, blueprint *blueprint.Blueprint) schema.Schemable {
+	if condition.FromString(name) != condition.UNKNOWN {
+		predicate, err := predicatefactory.Instance().BuildByName(name)
+		if err != nil {
+			return nil
+		}
+		return predicate.Schema()
+	}
+
+	if pluginConfig, isPlugin := blueprint.Plugins[name]; isPlugin {
+		prdPlugin, err := plugin.New(name, pluginConfig.Path, pluginConfig.Args, pluginConfig.Timeout)
+		if err != nil {
+			return nil
+		}
+
+		prdSchema, err := prdPlugin.Schema()
+		if err != nil {
+			return nil
+		}
+		return prdSchema
+	}
+	return nil
+}
+
+func checkRawArguments(rawArgs any, prdSchema schema.Schemable) error {
+	argsAttr, takesArgs := prdSchema.GetAttributes()["Arguments"]
+	if !takesArgs {
+		if rawArgs != nil {
+			return fmt.Errorf("arguments: not expected, got %s", rawTypeName(rawArgs))
+		}
+		return nil
+	}
+
+	if rawArgs == nil {
+		if argsAttr.IsRequired() {
+			return fmt.Errorf("arguments: required, expected %s", typed.TypeDescription(argsAttr.Type()))
+		}
+		return nil
+	}
+	return checkRawType(rawArgs, argsAttr.Type(), "arguments")
+}
+
+func checkRawType(raw any, typ typed.Typeable, at string) error {
+	switch t := typ.(type) {
+	case nil, *typed.GenericTyped:
+		return nil
+	case *typed.VariantTyped:
+		return checkRawVariant(raw, t, at)
+	case *typed.TupleTyped:
+		return checkRawTuple(raw, t, at)
+	}
+
+	expectedHint := typ.Hint().TypeHint()
+	if expectedHint == typed.Object {
+		expectedHint = typed.Map
+	}
+
+	if rawTypeHint(raw) != expectedHint {
+		return fmt.Errorf("%s: expected %s, got %s", at, typed.TypeDescription(typ), rawTypeName(raw))
+	}
+
+	switch t := typ.(type) {
+	case *typed.ListTyped:
+		for idx, elem := range raw.([]any) {
+			if err := checkRawType(elem, t.ElementsType, fmt.Sprintf("%s[%d]", at, idx)); err != nil {
+				return err
+			}
+		}
+	case *typed.MapTyped:
+		fields, err := rawFields(raw, at)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range sortedFieldNames(fields) {
+			if err := checkRawType(fields[key], t.ElementsType, fmt.Sprintf("%s.%s", at, key)); err != nil {
+				return err
+			}
+		}
+	case *typed.ObjectTyped:
+		fields, err := rawFields(raw, at)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range sortedFieldNames(fields) {
+			fieldType, known := t.FieldsTypes[key]
+			if !known {
+				return fmt.Errorf("%s: unknown field '%s'", at, key)
+			}
+
+			if err := checkRawType(fields[key], fieldType, fmt.Sprintf("%s.%s", at, key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkRawTuple(raw any, tupleType *typed.TupleTyped, at string) error {
+	elements, isList := raw.([]any)
+
+	// a tuple of a single element takes its element as is as well
+	if len(tupleType.ElementsTypes) == 1 {
+		elemErr := checkRawType(raw, tupleType.ElementsTypes[0], at)
+		if elemErr == nil || !isList || len(elements) != 1 {
+			return elemErr
+		}
+		return checkRawType(elements[0], tupleType.ElementsTypes[0], fmt.Sprintf("%s[0]", at))
+	}
+
+	if !isList {
+		return fmt.Errorf("%s: expected %s, got %s", at, typed.TypeDescription(tupleType), rawTypeName(raw))
+	}
+
+	if len(elements) != len(tupleType.ElementsTypes) {
+		return fmt.Errorf("%s: expected %d elements - %s, got %d", at, len(tupleType.ElementsTypes), typed.TypeDescription(tupleType), len(elements))
+	}
+
+	for idx, elem := range elements {
+		if err := checkRawType(elem, tupleType.ElementsTypes[idx], fmt.Sprintf("%s[%d]", at, idx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkRawVariant(raw any, variantType *typed.VariantTyped, at string) error {
+	var closestErr error
+	for _, variant := range variantType.VariantsTypes {
+		err := checkRawType(raw, variant, at)
+		if err == nil {
+			return nil
+		}
+
+		// prefer reporting why the value doesn't fit the variant of its own kind
+		if closestErr == nil && variantKindMatches(raw, variant) {
+			closestErr = err
+		}
+	}
+
+	if closestErr != nil {
+		return closestErr
+	}
+	return fmt.Errorf("%s: expected %s, got %s", at, typed.TypeDescription(variantType), rawTypeName(raw))
+}
+
+func variantKindMatches(raw any, variant typed.Typeable) bool {
+	if _, isTuple := variant.(*typed.TupleTyped); isTuple {
+		_, isList := raw.([]any)
+		return isList
+	}
+
+	rawHint := rawTypeHint(raw)
+	variantHint := variant.Hint().TypeHint()
+	return rawHint == variantHint || (rawHint == typed.Map && variantHint == typed.Object)
+}
+
+func rawTypeHint(raw any) typed.TypeHint {
+	switch raw.(type) {
+	case string:
+		return typed.String
+	case bool:
+		return typed.Boolean
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return typed.Number
+	case []any:
+		return typed.List
+	case map[string]any, map[any]any:
+		return typed.Map
+	default:
+		return typed.Invalid
+	}
+}
+
+func rawTypeName(raw any) string {
+	if raw == nil {
+		return "null"
+	}
+	return typed.NamedTypeFromTypeHint(rawTypeHint(raw))
+}
+
+func rawFields(raw any, at string) (map[string]any, error) {
+	switch rawMap := raw.(type) {
+	case map[string]any:
+		return rawMap, nil
+	case map[any]any:
+		fields := make(map[string]any, len(rawMap))
+		for key, value := range rawMap {
+			keyStr, isStr := key.(string)
+			if !isStr {
+				return nil, fmt.Errorf("%s: expected string keys, got key %v", at, key)
+			}
+			fields[keyStr] = value
+		}
+		return fields, nil
+	}
+	return nil, fmt.Errorf("%s: expected map, got %s", at, rawTypeName(raw))
+}
+
+func sortedFieldNames(fields map[string]any) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/internal/blueprint/elements"
+	"github.com/conformize/conformize/predicates/predicatefactory"
+)
+
+func TestRuleArgumentSchemaValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate string
+		args      elements.Value
+		wantErr   string
+	}{
+		{name: "accepts number for number argument", predicate: "gt", args: &elements.RawValue{Value: 5}},
+		{name: "rejects string for number argument", predicate: "gt", args: &elements.RawValue{Value: "5"}, wantErr: "arguments: expected number, got string"},
+		{name: "accepts tuple of bounds", predicate: "range", args: &elements.RawValue{Value: []any{1, 10.5}}},
+		{name: "rejects tuple with missing bound", predicate: "range", args: &elements.RawValue{Value: []any{1}}, wantErr: "arguments: expected 2 elements - tuple(number, number), got 1"},
+		{name: "rejects tuple element of wrong type", predicate: "range", args: &elements.RawValue{Value: []any{1, "ten"}}, wantErr: "arguments[1]: expected number, got string"},
+		{name: "accepts single element tuple given as is", predicate: "matches", args: &elements.RawValue{Value: "^https://"}},
+		{name: "accepts single element tuple given as list", predicate: "gte", args: &elements.RawValue{Value: []any{5}}},
+		{name: "accepts list for single element tuple of list", predicate: "has", args: &elements.RawValue{Value: []any{"light", "dark"}}},
+		{name: "rejects missing required arguments", predicate: "matches", args: &elements.RawValue{}, wantErr: "arguments: required, expected tuple(string)"},
+		{name: "accepts missing optional arguments", predicate: "future", args: &elements.RawValue{}},
+		{name: "accepts optional threshold argument", predicate: "inFuture", args: &elements.RawValue{Value: "now+30d"}},
+		{name: "rejects arguments for predicate without arguments", predicate: "true", args: &elements.RawValue{Value: true}, wantErr: "arguments: not expected, got boolean"},
+		{name: "accepts any variant", predicate: "hasKeys", args: &elements.RawValue{Value: "name"}},
+		{name: "reports element error of matching variant", predicate: "hasKeys", args: &elements.RawValue{Value: []any{"name", 1}}, wantErr: "arguments[1]: expected string, got number"},
+		{name: "rejects value matching no variant", predicate: "hasKeys", args: &elements.RawValue{Value: 1}, wantErr: "arguments: expected string | list(string), got number"},
+		{name: "accepts yaml map", predicate: "after", args: &elements.RawValue{Value: map[any]any{"date": 1700000000, "timezone": "UTC"}}},
+		{name: "rejects map element of wrong type", predicate: "valid", args: &elements.RawValue{Value: map[string]any{"layout": 1}}, wantErr: "arguments.layout: expected string, got number"},
+		{name: "skips path arguments", predicate: "gt", args: &elements.PathValue{}},
+	}
+
+	argSchemaValidator := &ruleArgumentSchemaValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			predicate, err := predicatefactory.Instance().BuildByName(tt.predicate)
+			if err != nil {
+				t.Fatalf("BuildByName() error = %v", err)
+			}
+
+			err = argSchemaValidator.Validate(tt.args, predicate.Schema())
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want none", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -10,15 +10,18 @@ package validation
 import (
 	"fmt"
 
+	"github.com/conformize/conformize/internal/blueprint"
 	"github.com/conformize/conformize/internal/blueprint/elements"
-	"github.com/conformize/conformize/predicates/condition"
+	"github.com/conformize/conformize/predicates/predicate/macro"
 )
 
 type ruleValidator struct {
 	ruleArgumentValidator
+	ruleArgumentSchemaValidator
 }
 
-func (v *ruleValidator) Validate(rule *elements.Rule, configSources map[string]elements.ConfigurationSource, refs map[string]string) error {
+func (v *ruleValidator) Validate(rule *elements.Rule, blueprint *blueprint.Blueprint) error {
+	configSources, refs := blueprint.Sources, blueprint.References
 	if len(rule.Value.Steps()) == 0 {
 		return fmt.Errorf("value path not specified")
 	}
@@ -30,13 +33,24 @@ func (v *ruleValidator) Validate(rule *elements.Rule, configSources map[string]e
 		}
 	}
 
-	predicate := condition.FromString(rule.Predicate)
-	if predicate == condition.UNKNOWN {
+	if !predicateResolvable(rule.Predicate, blueprint) {
 		return fmt.Errorf("couldn't resolve predicate '%s'", rule.Predicate)
 	}
 
 	if err := v.ruleArgumentValidator.Validate(rule.Arguments, configSources, refs); err != nil {
 		return err
 	}
+
+	if err := v.ruleArgumentSchemaValidator.Validate(rule.Arguments, predicateSchema(rule.Predicate, blueprint)); err != nil {
+		return fmt.Errorf("predicate '%s': %w", rule.Predicate, err)
+	}
+
+	if prdDef, isDefined := blueprint.Predicates[rule.Predicate]; isDefined {
+		if rawArg, isRaw := rule.Arguments.(*elements.RawValue); isRaw {
+			if _, err := macro.BindArguments(prdDef.Parameters, rawArg.Value); err != nil {
+				return fmt.Errorf("arguments don't match parameters of predicate '%s': %w", rule.Predicate, err)
+			}
+		}
+	}
 	return nil
 }
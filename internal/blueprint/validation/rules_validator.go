@@ -30,7 +30,7 @@ func (rlsVld *BlueprintRulesValidator) Validate(blueprint *blueprint.Blueprint)
 	}
 
 	for rIdx, rule := range blueprint.Ruleset {
-		if err := rlsVld.ruleValidator.Validate(&rule, blueprint.Sources, blueprint.References); err != nil {
+		if err := rlsVld.ruleValidator.Validate(&rule, blueprint); err != nil {
 			diags.Append(diagnostics.Builder().
 				Error().
 				Details(fmt.Sprintf("\nRule [%d]: %s", rIdx+1, err.Error())).
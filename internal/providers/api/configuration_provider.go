@@ -13,6 +13,9 @@ import (
 	"github.com/conformize/conformize/internal/providers/api/schema"
 )
 
+// SensitiveAttribute marks values provided as secrets, which are never shown in rule results.
+const SensitiveAttribute = "sensitive"
+
 type ConfigurationProvider interface {
 	Alias() string
 	ConfigurationSchema() *schema.Schema
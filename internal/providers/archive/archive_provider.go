@@ -0,0 +1,294 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/serialization"
+	"github.com/conformize/conformize/serialization/unmarshal"
+)
+
+// maxEntrySize limits the size of a decompressed entry read into memory.
+const maxEntrySize = 32 << 20
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+	tarMagic  = []byte("ustar")
+)
+
+type archiveProviderConfig struct {
+	Path     string   `cnfrmz:"path"`
+	Patterns []string `cnfrmz:"patterns"`
+	Format   string   `cnfrmz:"format"`
+}
+
+// ArchiveProvider decodes entries of a zip, jar, war, tar or tar.gz archive, without extracting it.
+type ArchiveProvider struct {
+	alias    string
+	path     string
+	patterns []string
+	format   string
+}
+
+// entryReader reads the entries of an archive, passing each one's path and content.
+type entryReader func(archive *os.File, size int64, read func(entryPath string, content io.Reader) error) error
+
+func New(alias string) *ArchiveProvider {
+	return &ArchiveProvider{alias: alias}
+}
+
+func (archivePrvdr *ArchiveProvider) Alias() string {
+	return archivePrvdr.alias
+}
+
+func (archivePrvdr *ArchiveProvider) ConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Configuration for the archive provider",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"path":     &attributes.StringAttribute{Required: true, Description: "Path to a zip, jar, war, tar or tar.gz archive"},
+			"patterns": &attributes.ListAttribute{ElementsType: &typed.StringTyped{}, Required: true, Description: "Glob patterns of the entries to read, where ** matches any number of directories"},
+			"format":   &attributes.StringAttribute{Description: "Format of the entries, inferred from their extension by default"},
+		},
+	}
+}
+
+func (archivePrvdr *ArchiveProvider) Configure(req *sdk.ConfigurationRequest) error {
+	var config archiveProviderConfig
+	if err := req.Get(&config); err != nil {
+		return err
+	}
+
+	if len(config.Path) == 0 {
+		return fmt.Errorf("path must be specified")
+	}
+
+	if len(config.Patterns) == 0 {
+		return fmt.Errorf("at least one pattern must be specified")
+	}
+
+	for _, pattern := range config.Patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+	}
+
+	if len(config.Format) > 0 {
+		if _, err := unmarshal.ForFormat(config.Format); err != nil {
+			return err
+		}
+	}
+
+	archivePath, err := util.ResolveFilePath(config.Path)
+	if err != nil {
+		return fmt.Errorf("couldn't resolve %s: %w", config.Path, err)
+	}
+
+	archivePrvdr.path = archivePath
+	archivePrvdr.patterns = config.Patterns
+	archivePrvdr.format = config.Format
+	return nil
+}
+
+func (archivePrvdr *ArchiveProvider) ProvisionDataRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Archive resource request schema",
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+}
+
+// Provide places each matching entry under its path in the archive,
+// e.g. $artifact.'BOOT-INF/classes/application.yaml'.
+func (archivePrvdr *ArchiveProvider) Provide(req *sdk.ProviderDataRequest) (*ds.Node[string, any], *diagnostics.Diagnostics) {
+	diags := diagnostics.NewDiagnostics()
+	archive, err := os.Open(archivePrvdr.path)
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+		return nil, diags
+	}
+	defer archive.Close()
+
+	info, err := archive.Stat()
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+		return nil, diags
+	}
+
+	readEntries, err := entryReaderOf(archive)
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("%s: %s", archivePrvdr.path, err.Error())).Build())
+		return nil, diags
+	}
+
+	root := ds.NewNode[string, any]()
+	err = readEntries(archive, info.Size(), func(entryPath string, content io.Reader) error {
+		entryPath = strings.TrimPrefix(path.Clean("/"+entryPath), "/")
+		if !archivePrvdr.matches(entryPath) {
+			return nil
+		}
+
+		if _, found := root.GetChildren(entryPath); found {
+			return fmt.Errorf("duplicate entry %s", entryPath)
+		}
+
+		entryNode, err := archivePrvdr.decodeEntry(entryPath, content)
+		if err != nil {
+			return err
+		}
+		root.Append(entryPath, entryNode)
+		return nil
+	})
+
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("%s: %s", archivePrvdr.path, err.Error())).Build())
+		return nil, diags
+	}
+
+	if len(root.Children()) == 0 {
+		diags.Append(diagnostics.Builder().Warning().Details(fmt.Sprintf("no entries of %s match %v", archivePrvdr.path, archivePrvdr.patterns)).Build())
+	}
+	return root, diags
+}
+
+func (archivePrvdr *ArchiveProvider) matches(entryPath string) bool {
+	for _, pattern := range archivePrvdr.patterns {
+		if util.MatchGlob(strings.TrimPrefix(pattern, "/"), entryPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func (archivePrvdr *ArchiveProvider) decodeEntry(entryPath string, content io.Reader) (*ds.Node[string, any], error) {
+	unmarshaller, err := unmarshal.ForFile(entryPath, archivePrvdr.format)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(content, maxEntrySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read %s: %w", entryPath, err)
+	}
+
+	if len(data) > maxEntrySize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", entryPath, maxEntrySize)
+	}
+
+	entryNode, err := unmarshaller.Unmarshal(serialization.NewBufferedData(data))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode %s: %w", entryPath, err)
+	}
+	return entryNode, nil
+}
+
+// entryReaderOf recognizes the format of an archive by its content rather than by its extension.
+func entryReaderOf(archive *os.File) (entryReader, error) {
+	header := make([]byte, 262)
+	headerSize, err := io.ReadFull(archive, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	header = header[:headerSize]
+
+	switch {
+	case bytes.HasPrefix(header, zipMagic):
+		return readZipEntries, nil
+	case bytes.HasPrefix(header, gzipMagic):
+		return readTarGzEntries, nil
+	case len(header) == 262 && bytes.Equal(header[257:], tarMagic):
+		return readTarEntries, nil
+	}
+	return nil, fmt.Errorf("not a zip, tar or tar.gz archive")
+}
+
+func readZipEntries(archive *os.File, size int64, read func(entryPath string, content io.Reader) error) error {
+	zipReader, err := zip.NewReader(archive, size)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range zipReader.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+
+		content, err := entry.Open()
+		if err != nil {
+			return fmt.Errorf("couldn't open %s: %w", entry.Name, err)
+		}
+
+		err = read(entry.Name, content)
+		content.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readTarGzEntries(archive *os.File, size int64, read func(entryPath string, content io.Reader) error) error {
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	return readTar(gzipReader, read)
+}
+
+func readTarEntries(archive *os.File, size int64, read func(entryPath string, content io.Reader) error) error {
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return readTar(archive, read)
+}
+
+func readTar(archive io.Reader, read func(entryPath string, content io.Reader) error) error {
+	tarReader := tar.NewReader(archive)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if err := read(header.Name, tarReader); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/conformize/conformize/internal/providers/api"
+)
+
+type entry struct {
+	name    string
+	content string
+}
+
+var artifactEntries = []entry{
+	{name: "META-INF/MANIFEST.MF", content: "Manifest-Version: 1.0\n"},
+	{name: "BOOT-INF/classes/application.yaml", content: "server:\n  port: 8080\n"},
+	{name: "BOOT-INF/classes/config/db.properties", content: "pool.size=10\n"},
+	{name: "./config/app.json", content: `{"replicas": 3}`},
+}
+
+func zipArchive(t *testing.T, entries []entry) []byte {
+	var archive bytes.Buffer
+	zipWriter := zip.NewWriter(&archive)
+	for _, e := range entries {
+		entryWriter, err := zipWriter.Create(e.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entryWriter.Write([]byte(e.content))
+	}
+	zipWriter.Close()
+	return archive.Bytes()
+}
+
+func tarArchive(t *testing.T, entries []entry) []byte {
+	var archive bytes.Buffer
+	tarWriter := tar.NewWriter(&archive)
+	tarWriter.WriteHeader(&tar.Header{Name: "BOOT-INF/", Typeflag: tar.TypeDir, Mode: 0755})
+	for _, e := range entries {
+		if err := tarWriter.WriteHeader(&tar.Header{Name: e.name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(e.content))}); err != nil {
+			t.Fatal(err)
+		}
+		tarWriter.Write([]byte(e.content))
+	}
+	tarWriter.Close()
+	return archive.Bytes()
+}
+
+func tarGzArchive(t *testing.T, entries []entry) []byte {
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	gzipWriter.Write(tarArchive(t, entries))
+	gzipWriter.Close()
+	return archive.Bytes()
+}
+
+func writeArchive(t *testing.T, archive []byte, name string) string {
+	archivePath := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(archivePath, archive, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return archivePath
+}
+
+func TestArchiveProviderReadsMatchingEntries(t *testing.T) {
+	var archives = []struct {
+		name    string
+		archive []byte
+	}{
+		{name: "app.jar", archive: zipArchive(t, artifactEntries)},
+		{name: "app.tar", archive: tarArchive(t, artifactEntries)},
+		{name: "app.tar.gz", archive: tarGzArchive(t, artifactEntries)},
+		{name: "app.bin", archive: zipArchive(t, artifactEntries)},
+	}
+
+	var testCases = []struct {
+		patterns []string
+		expected []string
+	}{
+		{patterns: []string{"BOOT-INF/classes/*"}, expected: []string{"BOOT-INF/classes/application.yaml"}},
+		{patterns: []string{"**/*.properties", "config/*.json"}, expected: []string{"BOOT-INF/classes/config/db.properties", "config/app.json"}},
+		{patterns: []string{"/config/app.json"}, expected: []string{"config/app.json"}},
+		{patterns: []string{"*.yaml"}, expected: []string{}},
+	}
+
+	for _, archive := range archives {
+		archivePath := writeArchive(t, archive.archive, archive.name)
+		for _, testCase := range testCases {
+			archivePrvdr := New("artifact")
+			cfgReq := sdk.NewConfigurationRequest(archivePrvdr.ConfigurationSchema())
+			cfgReq.SetAtPath("path", archivePath)
+			cfgReq.SetAtPath("patterns", testCase.patterns)
+			if err := archivePrvdr.Configure(cfgReq); err != nil {
+				t.Fatalf("Failed to configure archive provider, reason: %s", err)
+			}
+
+			data, diags := archivePrvdr.Provide(sdk.NewProviderDataRequest(archivePrvdr.ProvisionDataRequestSchema()))
+			if diags.HasErrors() {
+				t.Errorf("Failed to read %v from %s, reason: %s", testCase.patterns, archive.name, diags.Errors().String())
+				continue
+			}
+
+			if len(data.Children()) != len(testCase.expected) {
+				t.Errorf("Expected %v to read %v from %s, got %d entries", testCase.patterns, testCase.expected, archive.name, len(data.Children()))
+			}
+
+			for _, entryPath := range testCase.expected {
+				if _, found := data.GetChildren(entryPath); !found {
+					t.Errorf("Expected %v to read %s from %s", testCase.patterns, entryPath, archive.name)
+				}
+			}
+		}
+	}
+}
+
+func TestArchiveProviderDecodesEntries(t *testing.T) {
+	archivePrvdr := New("artifact")
+	cfgReq := sdk.NewConfigurationRequest(archivePrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("path", writeArchive(t, tarGzArchive(t, artifactEntries), "app.tgz"))
+	cfgReq.SetAtPath("patterns", []string{"**/application.yaml"})
+	if err := archivePrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure archive provider, reason: %s", err)
+	}
+
+	data, diags := archivePrvdr.Provide(sdk.NewProviderDataRequest(archivePrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read archive, reason: %s", diags.Errors().String())
+	}
+
+	appConfig, _ := data.GetChildren("BOOT-INF/classes/application.yaml")
+	server, _ := appConfig.First().GetChildren("server")
+	if port, found := server.First().GetChildren("port"); !found || port.First().Value != 8080 {
+		t.Errorf("Expected entry to be decoded")
+	}
+}
+
+func TestArchiveProviderReturnsErrorWithInvalidConfiguration(t *testing.T) {
+	archivePath := writeArchive(t, zipArchive(t, artifactEntries), "app.zip")
+	var testCases = []struct {
+		name     string
+		patterns []string
+	}{
+		{name: "no patterns"},
+		{name: "malformed pattern", patterns: []string{"[*.json"}},
+	}
+
+	for _, testCase := range testCases {
+		archivePrvdr := New("artifact")
+		cfgReq := sdk.NewConfigurationRequest(archivePrvdr.ConfigurationSchema())
+		cfgReq.SetAtPath("path", archivePath)
+		cfgReq.SetAtPath("patterns", testCase.patterns)
+		if err := archivePrvdr.Configure(cfgReq); err == nil {
+			t.Errorf("%s: expected error", testCase.name)
+		}
+	}
+}
+
+func TestArchiveProviderReturnsErrorWhenArchiveCantBeRead(t *testing.T) {
+	duplicates := append(artifactEntries, entry{name: "config/app.json", content: "{}"})
+	var testCases = []struct {
+		name     string
+		archive  []byte
+		patterns []string
+	}{
+		{name: "unknown format", archive: zipArchive(t, artifactEntries), patterns: []string{"META-INF/*"}},
+		{name: "not an archive", archive: []byte("replicas: 3\n"), patterns: []string{"**"}},
+		{name: "malformed entry", archive: zipArchive(t, []entry{{name: "app.json", content: "{"}}), patterns: []string{"*.json"}},
+		{name: "duplicate entry", archive: tarArchive(t, duplicates), patterns: []string{"config/*"}},
+	}
+
+	for _, testCase := range testCases {
+		archivePrvdr := New("artifact")
+		cfgReq := sdk.NewConfigurationRequest(archivePrvdr.ConfigurationSchema())
+		cfgReq.SetAtPath("path", writeArchive(t, testCase.archive, "app.zip"))
+		cfgReq.SetAtPath("patterns", testCase.patterns)
+		if err := archivePrvdr.Configure(cfgReq); err != nil {
+			t.Fatalf("%s: failed to configure archive provider, reason: %s", testCase.name, err)
+		}
+
+		if _, diags := archivePrvdr.Provide(sdk.NewProviderDataRequest(archivePrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+			t.Errorf("%s: expected error", testCase.name)
+		}
+	}
+}
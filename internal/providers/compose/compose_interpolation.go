@@ -0,0 +1,148 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package compose
+
+import (
+	"fmt"
+	"strings"
+)
+
+// interpolate substitutes the variables in a value the way Compose does: $VAR and ${VAR},
+// ${VAR:-default} and ${VAR-default}, ${VAR:?error} and ${VAR?error}, ${VAR:+replacement} and
+// ${VAR+replacement}, with $$ escaping a dollar sign. Variables which aren't set and have no
+// default are substituted with an empty string and reported.
+func interpolate(value string, lookup func(string) (string, bool)) (string, []string, error) {
+	var result strings.Builder
+	var unset []string
+	for pos := 0; pos < len(value); pos++ {
+		if value[pos] != '$' || pos+1 == len(value) {
+			result.WriteByte(value[pos])
+			continue
+		}
+
+		switch next := value[pos+1]; {
+		case next == '$':
+			result.WriteByte('$')
+			pos++
+		case next == '{':
+			end := closingBrace(value, pos+2)
+			if end == -1 {
+				return "", nil, fmt.Errorf("missing closing brace in '%s'", value)
+			}
+
+			substituted, unsetVars, err := substitute(value[pos+2:end], lookup)
+			if err != nil {
+				return "", nil, err
+			}
+			result.WriteString(substituted)
+			unset = append(unset, unsetVars...)
+			pos = end
+		case isNameStart(next):
+			end := pos + 2
+			for end < len(value) && isNameCharacter(value[end]) {
+				end++
+			}
+
+			name := value[pos+1 : end]
+			if varValue, found := lookup(name); found {
+				result.WriteString(varValue)
+			} else {
+				unset = append(unset, name)
+			}
+			pos = end - 1
+		default:
+			result.WriteByte('$')
+		}
+	}
+	return result.String(), unset, nil
+}
+
+// substitute resolves the expression within ${...}.
+func substitute(expr string, lookup func(string) (string, bool)) (string, []string, error) {
+	nameEnd := 0
+	for nameEnd < len(expr) && isNameCharacter(expr[nameEnd]) {
+		nameEnd++
+	}
+
+	name := expr[:nameEnd]
+	if len(name) == 0 || !isNameStart(name[0]) {
+		return "", nil, fmt.Errorf("invalid variable in '${%s}'", expr)
+	}
+
+	varValue, found := lookup(name)
+	if nameEnd == len(expr) {
+		if !found {
+			return "", []string{name}, nil
+		}
+		return varValue, nil, nil
+	}
+
+	operator := expr[nameEnd : nameEnd+1]
+	operand := expr[nameEnd+1:]
+	requireNonEmpty := operator == ":"
+	if requireNonEmpty {
+		if len(operand) == 0 {
+			return "", nil, fmt.Errorf("invalid variable in '${%s}'", expr)
+		}
+		operator, operand = operand[:1], operand[1:]
+	}
+
+	isSet := found && (!requireNonEmpty || len(varValue) > 0)
+	switch operator {
+	case "-":
+		if isSet {
+			return varValue, nil, nil
+		}
+		return interpolate(operand, lookup)
+	case "+":
+		if isSet {
+			return interpolate(operand, lookup)
+		}
+		return "", nil, nil
+	case "?":
+		if isSet {
+			return varValue, nil, nil
+		}
+
+		message, _, err := interpolate(operand, lookup)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if len(message) == 0 {
+			message = "must be set"
+		}
+		return "", nil, fmt.Errorf("required variable %s: %s", name, message)
+	}
+	return "", nil, fmt.Errorf("invalid variable in '${%s}'", expr)
+}
+
+// closingBrace finds the brace closing an expression, allowing expressions nested in its default.
+func closingBrace(value string, start int) int {
+	depth := 1
+	for pos := start; pos < len(value); pos++ {
+		switch value[pos] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return pos
+			}
+		}
+	}
+	return -1
+}
+
+func isNameStart(ch byte) bool {
+	return ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch == '_'
+}
+
+func isNameCharacter(ch byte) bool {
+	return isNameStart(ch) || ch >= '0' && ch <= '9'
+}
@@ -0,0 +1,59 @@
+package compose
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInterpolate(t *testing.T) {
+	vars := map[string]string{"TAG": "1.2.0", "EMPTY": "", "REGISTRY": "ghcr.io"}
+	lookup := func(name string) (string, bool) {
+		value, found := vars[name]
+		return value, found
+	}
+
+	var testCases = []struct {
+		value    string
+		expected string
+		unset    []string
+	}{
+		{value: "app:$TAG", expected: "app:1.2.0"},
+		{value: "app:${TAG}-alpine", expected: "app:1.2.0-alpine"},
+		{value: "${MISSING:-latest}", expected: "latest"},
+		{value: "${EMPTY:-latest}", expected: "latest"},
+		{value: "${EMPTY-latest}", expected: ""},
+		{value: "${MISSING-latest}", expected: "latest"},
+		{value: "${TAG:+pinned}", expected: "pinned"},
+		{value: "${EMPTY:+pinned}", expected: ""},
+		{value: "${EMPTY+pinned}", expected: "pinned"},
+		{value: "${MISSING:-${REGISTRY}/app}", expected: "ghcr.io/app"},
+		{value: "$$TAG costs $5", expected: "$TAG costs $5"},
+		{value: "app:$MISSING", expected: "app:", unset: []string{"MISSING"}},
+		{value: "${MISSING}", expected: "", unset: []string{"MISSING"}},
+	}
+
+	for _, testCase := range testCases {
+		interpolated, unset, err := interpolate(testCase.value, lookup)
+		if err != nil {
+			t.Errorf("Failed to interpolate %s, reason: %s", testCase.value, err.Error())
+			continue
+		}
+
+		if interpolated != testCase.expected {
+			t.Errorf("Expected %s to be interpolated as '%s', got '%s'", testCase.value, testCase.expected, interpolated)
+		}
+
+		if !reflect.DeepEqual(unset, testCase.unset) {
+			t.Errorf("Expected %s to report unset %v, got %v", testCase.value, testCase.unset, unset)
+		}
+	}
+}
+
+func TestInterpolateReturnsError(t *testing.T) {
+	lookup := func(name string) (string, bool) { return "", name == "EMPTY" }
+	for _, value := range []string{"${MISSING:?must be set}", "${EMPTY:?}", "${MISSING?}", "${TAG", "${1TAG}", "${TAG:x}"} {
+		if _, _, err := interpolate(value, lookup); err == nil {
+			t.Errorf("Expected error interpolating %s", value)
+		}
+	}
+}
@@ -0,0 +1,498 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package compose
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/serialization/unmarshal/env"
+	unmarshalfns "github.com/conformize/conformize/serialization/unmarshal/functions"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultEnvFile = ".env"
+
+// replacedKeys are the keys whose lists an override replaces instead of extending.
+var replacedKeys = map[string]struct{}{"command": {}, "entrypoint": {}, "test": {}}
+
+// mappedKeys are the service keys which may be lists of KEY=VALUE entries, merged by key.
+var mappedKeys = map[string]struct{}{"environment": {}, "labels": {}}
+
+type composeProviderConfig struct {
+	Files       []string          `cnfrmz:"files"`
+	Profiles    []string          `cnfrmz:"profiles"`
+	EnvFile     string            `cnfrmz:"envFile"`
+	Environment map[string]string `cnfrmz:"environment"`
+}
+
+// ComposeProvider reads a Compose application as it would run: its files interpolated, the services
+// they extend resolved, overrides merged in order and the services of inactive profiles left out.
+type ComposeProvider struct {
+	alias       string
+	files       []string
+	profiles    []string
+	envFile     string
+	environment map[string]string
+}
+
+func New(alias string) *ComposeProvider {
+	return &ComposeProvider{alias: alias}
+}
+
+func (composePrvdr *ComposeProvider) Alias() string {
+	return composePrvdr.alias
+}
+
+func (composePrvdr *ComposeProvider) ConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Configuration for the Compose provider",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"files":       &attributes.ListAttribute{ElementsType: &typed.StringTyped{}, Required: true, Description: "Compose files, each one overriding the previous ones"},
+			"profiles":    &attributes.ListAttribute{ElementsType: &typed.StringTyped{}, Description: "Profiles to enable"},
+			"envFile":     &attributes.StringAttribute{Description: "File with variables to interpolate, defaults to .env beside the first Compose file"},
+			"environment": &attributes.MapAttribute{ElementsType: &typed.StringTyped{}, Description: "Variables to interpolate, overriding the environment and the env file"},
+		},
+	}
+}
+
+func (composePrvdr *ComposeProvider) Configure(req *sdk.ConfigurationRequest) error {
+	var config composeProviderConfig
+	if err := req.Get(&config); err != nil {
+		return err
+	}
+
+	if len(config.Files) == 0 {
+		return fmt.Errorf("at least one file must be specified")
+	}
+
+	files := make([]string, 0, len(config.Files))
+	for _, file := range config.Files {
+		resolvedFile, err := util.ResolveFilePath(file)
+		if err != nil {
+			return fmt.Errorf("couldn't resolve %s: %w", file, err)
+		}
+		files = append(files, resolvedFile)
+	}
+
+	envFile := filepath.Join(filepath.Dir(files[0]), defaultEnvFile)
+	if len(config.EnvFile) > 0 {
+		resolvedFile, err := util.ResolveFilePath(config.EnvFile)
+		if err != nil {
+			return fmt.Errorf("couldn't resolve env file %s: %w", config.EnvFile, err)
+		}
+		envFile = resolvedFile
+	}
+
+	composePrvdr.files = files
+	composePrvdr.profiles = config.Profiles
+	composePrvdr.envFile = envFile
+	composePrvdr.environment = config.Environment
+	return nil
+}
+
+func (composePrvdr *ComposeProvider) ProvisionDataRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Compose resource request schema",
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+}
+
+// Provide exposes the resulting application, e.g. $compose.'services'.'api'.'privileged'.
+func (composePrvdr *ComposeProvider) Provide(req *sdk.ProviderDataRequest) (*ds.Node[string, any], *diagnostics.Diagnostics) {
+	diags := diagnostics.NewDiagnostics()
+	envFileVars, err := readEnvFile(composePrvdr.envFile)
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("couldn't read env file %s: %s", composePrvdr.envFile, err.Error())).Build())
+		return nil, diags
+	}
+
+	ldr := &loader{
+		lookup: func(name string) (string, bool) {
+			if value, found := composePrvdr.environment[name]; found {
+				return value, true
+			}
+
+			if value, found := os.LookupEnv(name); found {
+				return value, true
+			}
+
+			value, found := envFileVars[name]
+			return value, found
+		},
+		files:    make(map[string]map[string]any),
+		unset:    make(map[string]struct{}),
+		profiles: composePrvdr.profiles,
+	}
+
+	var project map[string]any
+	for _, file := range composePrvdr.files {
+		model, err := ldr.resolve(file)
+		if err != nil {
+			diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+			return nil, diags
+		}
+		project, _ = mergeValues("", project, model).(map[string]any)
+	}
+
+	for _, name := range ldr.unsetVariables() {
+		diags.Append(diagnostics.Builder().Warning().Details(fmt.Sprintf("variable %s is not set, defaulting to an empty string", name)).Build())
+	}
+
+	ldr.removeInactiveServices(project)
+	root := ds.NewNode[string, any]()
+	unmarshalfns.UnmarshalValue(root, project)
+	return root, diags
+}
+
+type loader struct {
+	lookup   func(string) (string, bool)
+	files    map[string]map[string]any
+	unset    map[string]struct{}
+	profiles []string
+}
+
+// load reads and interpolates a Compose file, once.
+func (ldr *loader) load(file string) (map[string]any, error) {
+	if model, loaded := ldr.files[file]; loaded {
+		return model, nil
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var model map[string]any
+	if err := yaml.Unmarshal(content, &model); err != nil {
+		return nil, fmt.Errorf("couldn't decode %s: %w", file, err)
+	}
+
+	interpolated, err := ldr.interpolateValue(model)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't interpolate %s: %w", file, err)
+	}
+
+	model, _ = interpolated.(map[string]any)
+	if model == nil {
+		model = make(map[string]any)
+	}
+
+	services, _ := model["services"].(map[string]any)
+	for _, service := range services {
+		if serviceModel, ok := service.(map[string]any); ok {
+			for key := range mappedKeys {
+				switch entries := serviceModel[key].(type) {
+				case []any:
+					serviceModel[key] = ldr.entriesMap(entries)
+				case map[string]any:
+					ldr.resolveEntries(entries)
+				}
+			}
+		}
+	}
+
+	ldr.files[file] = model
+	return model, nil
+}
+
+// resolve loads a Compose file with the services it defines merged over the services they extend.
+func (ldr *loader) resolve(file string) (map[string]any, error) {
+	model, err := ldr.load(file)
+	if err != nil {
+		return nil, err
+	}
+
+	services, _ := model["services"].(map[string]any)
+	resolved := make(map[string]any, len(services))
+	for name := range services {
+		service, err := ldr.resolveService(file, name, map[string]struct{}{})
+		if err != nil {
+			return nil, err
+		}
+		resolved[name] = service
+	}
+
+	result := make(map[string]any, len(model))
+	for key, value := range model {
+		result[key] = value
+	}
+
+	if len(resolved) > 0 {
+		result["services"] = resolved
+	}
+	return result, nil
+}
+
+func (ldr *loader) resolveService(file string, name string, visiting map[string]struct{}) (map[string]any, error) {
+	serviceRef := file + ":" + name
+	if _, isVisiting := visiting[serviceRef]; isVisiting {
+		return nil, fmt.Errorf("service %s in %s extends itself", name, file)
+	}
+	visiting[serviceRef] = struct{}{}
+
+	model, err := ldr.load(file)
+	if err != nil {
+		return nil, err
+	}
+
+	services, _ := model["services"].(map[string]any)
+	service, found := services[name].(map[string]any)
+	if !found {
+		if _, defined := services[name]; !defined {
+			return nil, fmt.Errorf("service %s not found in %s", name, file)
+		}
+		service = make(map[string]any)
+	}
+
+	extends, hasExtends := service["extends"]
+	if !hasExtends {
+		return service, nil
+	}
+
+	baseFile, baseName := file, ""
+	switch extendsValue := extends.(type) {
+	case string:
+		baseName = extendsValue
+	case map[string]any:
+		baseName, _ = extendsValue["service"].(string)
+		if extendsFile, _ := extendsValue["file"].(string); len(extendsFile) > 0 {
+			baseFile = extendsFile
+			if !filepath.IsAbs(baseFile) {
+				baseFile = filepath.Join(filepath.Dir(file), baseFile)
+			}
+		}
+	}
+
+	if len(baseName) == 0 {
+		return nil, fmt.Errorf("service %s in %s extends no service", name, file)
+	}
+
+	base, err := ldr.resolveService(baseFile, baseName, visiting)
+	if err != nil {
+		return nil, err
+	}
+
+	extended := make(map[string]any, len(service))
+	for key, value := range service {
+		if key != "extends" {
+			extended[key] = value
+		}
+	}
+
+	merged, _ := mergeValues("", base, extended).(map[string]any)
+	return merged, nil
+}
+
+func (ldr *loader) interpolateValue(value any) (any, error) {
+	switch val := value.(type) {
+	case string:
+		interpolated, unset, err := interpolate(val, ldr.lookup)
+		for _, name := range unset {
+			ldr.unset[name] = struct{}{}
+		}
+		return interpolated, err
+	case map[string]any:
+		result := make(map[string]any, len(val))
+		for key, item := range val {
+			interpolated, err := ldr.interpolateValue(item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = interpolated
+		}
+		return result, nil
+	case []any:
+		result := make([]any, 0, len(val))
+		for _, item := range val {
+			interpolated, err := ldr.interpolateValue(item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, interpolated)
+		}
+		return result, nil
+	}
+	return value, nil
+}
+
+// entriesMap converts KEY=VALUE entries to a map. An entry with no value takes it from the variables,
+// and is left out when the variable isn't set.
+func (ldr *loader) entriesMap(entries []any) map[string]any {
+	result := make(map[string]any, len(entries))
+	for _, entry := range entries {
+		entryStr := fmt.Sprint(entry)
+		if key, value, hasValue := strings.Cut(entryStr, "="); hasValue {
+			result[key] = value
+		} else if value, found := ldr.lookup(entryStr); found {
+			result[entryStr] = value
+		}
+	}
+	return result
+}
+
+// resolveEntries takes the values of entries with no value from the variables, leaving out the ones
+// which aren't set.
+func (ldr *loader) resolveEntries(entries map[string]any) {
+	for key, value := range entries {
+		if value != nil {
+			continue
+		}
+
+		if varValue, found := ldr.lookup(key); found {
+			entries[key] = varValue
+		} else {
+			delete(entries, key)
+		}
+	}
+}
+
+// removeInactiveServices removes the services of profiles which aren't enabled.
+func (ldr *loader) removeInactiveServices(project map[string]any) {
+	services, _ := project["services"].(map[string]any)
+	for name, service := range services {
+		serviceModel, _ := service.(map[string]any)
+		profiles, _ := serviceModel["profiles"].([]any)
+		if len(profiles) == 0 {
+			continue
+		}
+
+		active := false
+		for _, profile := range profiles {
+			for _, enabled := range ldr.profiles {
+				active = active || fmt.Sprint(profile) == enabled
+			}
+		}
+
+		if !active {
+			delete(services, name)
+		}
+	}
+}
+
+func (ldr *loader) unsetVariables() []string {
+	names := make([]string, 0, len(ldr.unset))
+	for name := range ldr.unset {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// mergeValues merges an override into a base value without modifying either. Mappings are merged
+// recursively, volumes are merged by their target, the lists of replacedKeys are replaced, and
+// other lists are extended with the items they don't contain yet.
+func mergeValues(key string, base any, override any) any {
+	baseMap, isBaseMap := base.(map[string]any)
+	overrideMap, isOverrideMap := override.(map[string]any)
+	if isBaseMap && isOverrideMap {
+		result := make(map[string]any, len(baseMap)+len(overrideMap))
+		for baseKey, value := range baseMap {
+			result[baseKey] = value
+		}
+
+		for overrideKey, value := range overrideMap {
+			result[overrideKey] = mergeValues(overrideKey, baseMap[overrideKey], value)
+		}
+		return result
+	}
+
+	baseList, isBaseList := base.([]any)
+	overrideList, isOverrideList := override.([]any)
+	if _, isReplaced := replacedKeys[key]; isReplaced || !isBaseList || !isOverrideList {
+		return override
+	}
+
+	result := make([]any, 0, len(baseList)+len(overrideList))
+	if key == "volumes" {
+		overridden := make(map[string]struct{}, len(overrideList))
+		for _, volume := range overrideList {
+			overridden[volumeTarget(volume)] = struct{}{}
+		}
+
+		for _, volume := range baseList {
+			if _, isOverridden := overridden[volumeTarget(volume)]; !isOverridden {
+				result = append(result, volume)
+			}
+		}
+		return append(result, overrideList...)
+	}
+
+	result = append(result, baseList...)
+	for _, item := range overrideList {
+		contained := false
+		for _, baseItem := range baseList {
+			contained = contained || reflect.DeepEqual(baseItem, item)
+		}
+
+		if !contained {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// volumeTarget returns the path a volume is mounted at, in its short or long syntax.
+func volumeTarget(volume any) string {
+	if volumeMap, ok := volume.(map[string]any); ok {
+		target, _ := volumeMap["target"].(string)
+		return target
+	}
+
+	parts := strings.Split(fmt.Sprint(volume), ":")
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return parts[1]
+}
+
+// readEnvFile reads the variables of an env file, which is optional.
+func readEnvFile(file string) (map[string]string, error) {
+	content, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string)
+	decoder := env.NewDecoder(bufio.NewReader(bytes.NewReader(append(content, '\n'))))
+	for {
+		key, value, err := decoder.DecodeString()
+		if errors.Is(err, io.EOF) {
+			return vars, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if key != nil {
+			vars[*key] = value
+		}
+	}
+}
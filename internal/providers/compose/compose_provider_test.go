@@ -0,0 +1,216 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	sdk "github.com/conformize/conformize/internal/providers/api"
+)
+
+var composeFiles = map[string]string{
+	"common.yaml": `
+services:
+  base:
+    image: ghcr.io/acme/base:${TAG:-latest}
+    environment:
+      - LOG_LEVEL=info
+      - REGION
+    cap_drop: [ALL]
+`,
+	"compose.yaml": `
+services:
+  api:
+    extends:
+      file: common.yaml
+      service: base
+    image: ghcr.io/acme/api:${TAG:-latest}
+    command: ["serve"]
+    ports: ["8080:8080"]
+    volumes:
+      - ./config:/etc/api
+      - data:/var/lib/api
+  debug:
+    image: busybox
+    privileged: true
+    profiles: [debug]
+volumes:
+  data: {}
+networks:
+  default:
+    name: ${NETWORK}
+`,
+	"compose.prod.yaml": `
+services:
+  api:
+    command: ["serve", "--prod"]
+    ports: ["443:8443"]
+    volumes:
+      - /srv/api:/etc/api
+    environment:
+      LOG_LEVEL: warn
+`,
+	".env": "TAG=1.4.0\nREGION=eu-west-1\n",
+}
+
+func writeComposeFiles(t *testing.T) string {
+	projectDir := t.TempDir()
+	for name, content := range composeFiles {
+		if err := os.WriteFile(filepath.Join(projectDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return projectDir
+}
+
+func TestComposeProviderMergesFiles(t *testing.T) {
+	projectDir := writeComposeFiles(t)
+
+	composePrvdr := New("compose")
+	cfgReq := sdk.NewConfigurationRequest(composePrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("files", []string{filepath.Join(projectDir, "compose.yaml"), filepath.Join(projectDir, "compose.prod.yaml")})
+	cfgReq.SetAtPath("environment", map[string]string{"NETWORK": "acme"})
+	if err := composePrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure compose provider, reason: %s", err)
+	}
+
+	data, diags := composePrvdr.Provide(sdk.NewProviderDataRequest(composePrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read compose files, reason: %s", diags.Errors().String())
+	}
+
+	services, _ := data.GetChildren("services")
+	api, found := services.First().GetChildren("api")
+	if !found {
+		t.Fatalf("Expected service api")
+	}
+
+	var testCases = []struct {
+		key      string
+		expected any
+	}{
+		{key: "image", expected: "ghcr.io/acme/api:1.4.0"},
+		{key: "command", expected: []any{"serve", "--prod"}},
+		{key: "ports", expected: []any{"8080:8080", "443:8443"}},
+		{key: "volumes", expected: []any{"data:/var/lib/api", "/srv/api:/etc/api"}},
+		{key: "cap_drop", expected: []any{"ALL"}},
+	}
+
+	for _, testCase := range testCases {
+		value, found := api.First().GetChildren(testCase.key)
+		if !found || !reflect.DeepEqual(value.First().Value, testCase.expected) {
+			t.Errorf("Expected %v at %s", testCase.expected, testCase.key)
+		}
+	}
+
+	environment, _ := api.First().GetChildren("environment")
+	if logLevel, found := environment.First().GetChildren("LOG_LEVEL"); !found || logLevel.First().Value != "warn" {
+		t.Errorf("Expected environment to be overridden")
+	}
+
+	if region, found := environment.First().GetChildren("REGION"); !found || region.First().Value != "eu-west-1" {
+		t.Errorf("Expected environment to be interpolated from the env file")
+	}
+
+	if _, found := api.First().GetChildren("extends"); found {
+		t.Errorf("Expected extends to be resolved")
+	}
+
+	networks, _ := data.GetChildren("networks")
+	defaultNetwork, _ := networks.First().GetChildren("default")
+	if name, found := defaultNetwork.First().GetChildren("name"); !found || name.First().Value != "acme" {
+		t.Errorf("Expected network name to be interpolated from the environment")
+	}
+
+	volumes, _ := data.GetChildren("volumes")
+	if _, found := volumes.First().GetChildren("data"); !found {
+		t.Errorf("Expected volumes")
+	}
+}
+
+func TestComposeProviderKeepsEnvFileValuesAsStrings(t *testing.T) {
+	projectDir := t.TempDir()
+	composeFile := filepath.Join(projectDir, "compose.yaml")
+	os.WriteFile(composeFile, []byte("services:\n  app:\n    image: app:${TAG}\n    command: [\"${PORT}\", \"${FLAG}\", \"${LIMIT}\"]\n"), 0644)
+	os.WriteFile(filepath.Join(projectDir, ".env"), []byte("TAG=1.10\nPORT=08080\nFLAG=TRUE\nLIMIT=inf\n"), 0644)
+
+	composePrvdr := New("compose")
+	cfgReq := sdk.NewConfigurationRequest(composePrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("files", []string{composeFile})
+	if err := composePrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure compose provider, reason: %s", err)
+	}
+
+	data, diags := composePrvdr.Provide(sdk.NewProviderDataRequest(composePrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read compose files, reason: %s", diags.Errors().String())
+	}
+
+	services, _ := data.GetChildren("services")
+	app, _ := services.First().GetChildren("app")
+	if image, found := app.First().GetChildren("image"); !found || image.First().Value != "app:1.10" {
+		t.Errorf("Expected image app:1.10")
+	}
+
+	expected := []any{"08080", "TRUE", "inf"}
+	if command, found := app.First().GetChildren("command"); !found || !reflect.DeepEqual(command.First().Value, expected) {
+		t.Errorf("Expected command %v", expected)
+	}
+}
+
+func TestComposeProviderEnablesProfiles(t *testing.T) {
+	projectDir := writeComposeFiles(t)
+	var testCases = []struct {
+		profiles []string
+		enabled  bool
+	}{
+		{profiles: nil, enabled: false},
+		{profiles: []string{"test"}, enabled: false},
+		{profiles: []string{"test", "debug"}, enabled: true},
+	}
+
+	for _, testCase := range testCases {
+		composePrvdr := New("compose")
+		cfgReq := sdk.NewConfigurationRequest(composePrvdr.ConfigurationSchema())
+		cfgReq.SetAtPath("files", []string{filepath.Join(projectDir, "compose.yaml")})
+		cfgReq.SetAtPath("profiles", testCase.profiles)
+		if err := composePrvdr.Configure(cfgReq); err != nil {
+			t.Fatalf("Failed to configure compose provider, reason: %s", err)
+		}
+
+		data, diags := composePrvdr.Provide(sdk.NewProviderDataRequest(composePrvdr.ProvisionDataRequestSchema()))
+		if diags.HasErrors() {
+			t.Fatalf("Failed to read compose files, reason: %s", diags.Errors().String())
+		}
+
+		services, _ := data.GetChildren("services")
+		if _, enabled := services.First().GetChildren("debug"); enabled != testCase.enabled {
+			t.Errorf("Expected debug service enabled with profiles %v: %t", testCase.profiles, testCase.enabled)
+		}
+
+		if _, enabled := services.First().GetChildren("api"); !enabled {
+			t.Errorf("Expected services without profiles to be enabled")
+		}
+	}
+}
+
+func TestComposeProviderReturnsErrorWithCyclicExtends(t *testing.T) {
+	projectDir := t.TempDir()
+	composeFile := filepath.Join(projectDir, "compose.yaml")
+	content := "services:\n  a:\n    extends: b\n  b:\n    extends: a\n"
+	if err := os.WriteFile(composeFile, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	composePrvdr := New("compose")
+	cfgReq := sdk.NewConfigurationRequest(composePrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("files", []string{composeFile})
+	if err := composePrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure compose provider, reason: %s", err)
+	}
+
+	if _, diags := composePrvdr.Provide(sdk.NewProviderDataRequest(composePrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error with cyclic extends")
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package file
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/serialization"
+	"github.com/conformize/conformize/serialization/unmarshal"
+)
+
+const globMetaCharacters = "*?["
+
+type filesProviderConfig struct {
+	Patterns []string `cnfrmz:"patterns"`
+	Name     string   `cnfrmz:"name"`
+	Format   string   `cnfrmz:"format"`
+}
+
+// fileName holds the parts of a file's path a name template can refer to.
+type fileName struct {
+	Path string
+	Dir  string
+	Base string
+	Name string
+	Ext  string
+}
+
+type filesProvider struct {
+	alias    string
+	patterns []string
+	name     *template.Template
+	format   string
+}
+
+func (filesPrvdr *filesProvider) Alias() string {
+	return filesPrvdr.alias
+}
+
+func (filesPrvdr *filesProvider) ConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Files provider schema",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"patterns": &attributes.ListAttribute{ElementsType: &typed.StringTyped{}, Required: true, Description: "Glob patterns of the files to read, where ** matches any number of directories"},
+			"name":     &attributes.StringAttribute{Description: "Template of the key each file is placed under, defaults to its path relative to the pattern"},
+			"format":   &attributes.StringAttribute{Description: "Format of the files, inferred from their extension by default"},
+		},
+	}
+}
+
+func (filesPrvdr *filesProvider) Configure(req *sdk.ConfigurationRequest) error {
+	var config filesProviderConfig
+	if err := req.Get(&config); err != nil {
+		return err
+	}
+
+	if len(config.Patterns) == 0 {
+		return fmt.Errorf("at least one pattern must be specified")
+	}
+
+	for _, pattern := range config.Patterns {
+		if _, err := path.Match(filepath.ToSlash(pattern), ""); err != nil {
+			return fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+	}
+
+	if len(config.Name) > 0 {
+		name, err := template.New("name").Option("missingkey=error").Parse(config.Name)
+		if err != nil {
+			return fmt.Errorf("invalid name template: %w", err)
+		}
+		filesPrvdr.name = name
+	}
+
+	if len(config.Format) > 0 {
+		if _, err := unmarshal.ForFormat(config.Format); err != nil {
+			return err
+		}
+	}
+
+	filesPrvdr.patterns = config.Patterns
+	filesPrvdr.format = config.Format
+	return nil
+}
+
+func (filesPrvdr *filesProvider) ProvisionDataRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Files resource request schema",
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+}
+
+// Provide places the content of each matched file under its key, e.g. $tenants.'acme.yaml'.
+func (filesPrvdr *filesProvider) Provide(req *sdk.ProviderDataRequest) (*ds.Node[string, any], *diagnostics.Diagnostics) {
+	diags := diagnostics.NewDiagnostics()
+	root := ds.NewNode[string, any]()
+	for _, pattern := range filesPrvdr.patterns {
+		baseDir, files, err := matchFiles(pattern)
+		if err != nil {
+			diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+			return nil, diags
+		}
+
+		if len(files) == 0 {
+			diags.Append(diagnostics.Builder().Warning().Details(fmt.Sprintf("pattern %s matched no files", pattern)).Build())
+			continue
+		}
+
+		for _, relPath := range files {
+			key, err := filesPrvdr.key(relPath)
+			if err != nil {
+				diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("couldn't name %s: %s", relPath, err.Error())).Build())
+				return nil, diags
+			}
+
+			if _, found := root.GetChildren(key); found {
+				diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("more than one file named '%s', the latest being %s", key, relPath)).Build())
+				return nil, diags
+			}
+
+			filePath := filepath.Join(baseDir, filepath.FromSlash(relPath))
+			fileNode, err := filesPrvdr.readFile(filePath)
+			if err != nil {
+				diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+				return nil, diags
+			}
+			fileNode.AddAttribute("path", filePath)
+			root.Append(key, fileNode)
+		}
+	}
+	return root, diags
+}
+
+func (filesPrvdr *filesProvider) readFile(filePath string) (*ds.Node[string, any], error) {
+	unmarshaller, err := unmarshal.ForFile(filePath, filesPrvdr.format)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSrc, err := serialization.NewFileSource(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := unmarshaller.Unmarshal(fileSrc)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode %s: %w", filePath, err)
+	}
+	return data, nil
+}
+
+// key names a file after the name template, or after its path relative to the pattern.
+func (filesPrvdr *filesProvider) key(relPath string) (string, error) {
+	if filesPrvdr.name == nil {
+		return relPath, nil
+	}
+
+	base := path.Base(relPath)
+	ext := path.Ext(base)
+	var name strings.Builder
+	err := filesPrvdr.name.Execute(&name, fileName{
+		Path: relPath,
+		Dir:  path.Dir(relPath),
+		Base: base,
+		Name: strings.TrimSuffix(base, ext),
+		Ext:  strings.TrimPrefix(ext, "."),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(name.String()) == 0 {
+		return "", fmt.Errorf("empty name")
+	}
+	return name.String(), nil
+}
+
+// matchFiles lists the files matching a pattern, relative to the directory the pattern starts from,
+// which is its part up to the first directory with a wildcard.
+func matchFiles(pattern string) (string, []string, error) {
+	segments := strings.Split(filepath.ToSlash(pattern), "/")
+	baseSegments := 0
+	for baseSegments < len(segments)-1 && !strings.ContainsAny(segments[baseSegments], globMetaCharacters) {
+		baseSegments++
+	}
+
+	baseDir := strings.Join(segments[:baseSegments], "/")
+	if len(baseDir) == 0 && baseSegments > 0 {
+		baseDir = "/"
+	}
+
+	if len(baseDir) == 0 {
+		baseDir = "."
+	}
+
+	resolvedDir, err := util.ResolveFilePath(filepath.FromSlash(baseDir))
+	if err != nil {
+		return "", nil, fmt.Errorf("couldn't resolve %s: %w", baseDir, err)
+	}
+
+	var files []string
+	filePattern := strings.Join(segments[baseSegments:], "/")
+	err = filepath.WalkDir(resolvedDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || filePath == resolvedDir {
+			return err
+		}
+
+		relPath, err := filepath.Rel(resolvedDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		relPath = filepath.ToSlash(relPath)
+		if entry.IsDir() {
+			if !util.MatchGlobDir(filePattern, relPath) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if util.MatchGlob(filePattern, relPath) {
+			files = append(files, relPath)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+	return resolvedDir, files, nil
+}
+
+func NewFilesProvider(alias string) *filesProvider {
+	return &filesProvider{alias: alias}
+}
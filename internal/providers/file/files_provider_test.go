@@ -0,0 +1,150 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/conformize/conformize/internal/providers/api"
+)
+
+func writeTenants(t *testing.T) string {
+	tenantsDir := t.TempDir()
+	files := map[string]string{
+		"acme.yaml":            "plan: enterprise\nseats: 250\n",
+		"globex.json":          `{"plan": "team", "seats": 12}`,
+		"eu/initech.toml":      "plan = \"team\"\nseats = 40\n",
+		"eu/archive/old.yaml":  "plan: legacy\n",
+		"README.md":            "# Tenants\n",
+		"eu/archive/notes.txt": "not a tenant\n",
+	}
+
+	for name, content := range files {
+		filePath := filepath.Join(tenantsDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tenantsDir
+}
+
+func TestFilesProviderReadsMatchingFiles(t *testing.T) {
+	tenantsDir := writeTenants(t)
+	var testCases = []struct {
+		patterns []string
+		name     string
+		expected []string
+	}{
+		{patterns: []string{"*.yaml", "*.json"}, expected: []string{"acme.yaml", "globex.json"}},
+		{patterns: []string{"**/*.toml"}, expected: []string{"eu/initech.toml"}},
+		{patterns: []string{"**/*.yaml"}, expected: []string{"acme.yaml", "eu/archive/old.yaml"}},
+		{patterns: []string{"eu/*.toml"}, expected: []string{"initech.toml"}},
+		{patterns: []string{"*.yaml", "*.json", "eu/*.toml"}, name: "{{.Name}}", expected: []string{"acme", "globex", "initech"}},
+		{patterns: []string{"**/*.yaml"}, name: "{{.Dir}}-{{.Name}}-{{.Ext}}", expected: []string{".-acme-yaml", "eu/archive-old-yaml"}},
+	}
+
+	for _, testCase := range testCases {
+		patterns := make([]string, 0, len(testCase.patterns))
+		for _, pattern := range testCase.patterns {
+			patterns = append(patterns, filepath.Join(tenantsDir, pattern))
+		}
+
+		filesPrvdr := NewFilesProvider("tenants")
+		cfgReq := sdk.NewConfigurationRequest(filesPrvdr.ConfigurationSchema())
+		cfgReq.SetAtPath("patterns", patterns)
+		cfgReq.SetAtPath("name", testCase.name)
+		if err := filesPrvdr.Configure(cfgReq); err != nil {
+			t.Fatalf("Failed to configure files provider, reason: %s", err)
+		}
+
+		data, diags := filesPrvdr.Provide(sdk.NewProviderDataRequest(filesPrvdr.ProvisionDataRequestSchema()))
+		if diags.HasErrors() {
+			t.Errorf("Failed to read %v, reason: %s", testCase.patterns, diags.Errors().String())
+			continue
+		}
+
+		if len(data.Children()) != len(testCase.expected) {
+			t.Errorf("Expected %v to be read as %v, got %d files", testCase.patterns, testCase.expected, len(data.Children()))
+		}
+
+		for _, key := range testCase.expected {
+			if _, found := data.GetChildren(key); !found {
+				t.Errorf("Expected %v to be read as %v, missing %s", testCase.patterns, testCase.expected, key)
+			}
+		}
+	}
+}
+
+func TestFilesProviderDecodesEachFileByExtension(t *testing.T) {
+	tenantsDir := writeTenants(t)
+
+	filesPrvdr := NewFilesProvider("tenants")
+	cfgReq := sdk.NewConfigurationRequest(filesPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("patterns", []string{filepath.Join(tenantsDir, "*.yaml"), filepath.Join(tenantsDir, "*.json"), filepath.Join(tenantsDir, "eu", "*.toml")})
+	cfgReq.SetAtPath("name", "{{.Name}}")
+	if err := filesPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure files provider, reason: %s", err)
+	}
+
+	data, diags := filesPrvdr.Provide(sdk.NewProviderDataRequest(filesPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read files, reason: %s", diags.Errors().String())
+	}
+
+	for tenant, plan := range map[string]string{"acme": "enterprise", "globex": "team", "initech": "team"} {
+		tenantNodes, _ := data.GetChildren(tenant)
+		planNodes, found := tenantNodes.First().GetChildren("plan")
+		if !found || planNodes.First().Value != plan {
+			t.Errorf("Expected plan of %s to be %s", tenant, plan)
+		}
+	}
+}
+
+func TestFilesProviderReturnsErrorWithUnknownFormat(t *testing.T) {
+	tenantsDir := writeTenants(t)
+
+	filesPrvdr := NewFilesProvider("tenants")
+	cfgReq := sdk.NewConfigurationRequest(filesPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("patterns", []string{filepath.Join(tenantsDir, "**", "*.*")})
+	cfgReq.SetAtPath("name", "{{.Name}}")
+	if err := filesPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure files provider, reason: %s", err)
+	}
+
+	if _, diags := filesPrvdr.Provide(sdk.NewProviderDataRequest(filesPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error reading files of unknown format")
+	}
+}
+
+func TestFilesProviderReturnsErrors(t *testing.T) {
+	tenantsDir := writeTenants(t)
+	var testCases = []struct {
+		patterns []string
+		name     string
+	}{
+		{},
+		{patterns: []string{filepath.Join(tenantsDir, "[.yaml")}},
+		{patterns: []string{filepath.Join(tenantsDir, "**", "*.yaml")}, name: "{{.Name"},
+		{patterns: []string{filepath.Join(tenantsDir, "**", "*.yaml")}, name: "{{.Tenant}}"},
+		{patterns: []string{filepath.Join(tenantsDir, "**", "*.yaml")}, name: "tenant"},
+		{patterns: []string{filepath.Join(tenantsDir, "missing", "*.yaml")}},
+	}
+
+	for _, testCase := range testCases {
+		filesPrvdr := NewFilesProvider("tenants")
+		cfgReq := sdk.NewConfigurationRequest(filesPrvdr.ConfigurationSchema())
+		cfgReq.SetAtPath("patterns", testCase.patterns)
+		cfgReq.SetAtPath("name", testCase.name)
+		if err := filesPrvdr.Configure(cfgReq); err != nil {
+			continue
+		}
+
+		if _, diags := filesPrvdr.Provide(sdk.NewProviderDataRequest(filesPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+			t.Errorf("Expected error with patterns %v and name '%s'", testCase.patterns, testCase.name)
+		}
+	}
+}
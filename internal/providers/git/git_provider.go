@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/serialization"
+	"github.com/conformize/conformize/serialization/unmarshal"
+)
+
+const defaultRef = "HEAD"
+
+type gitProviderConfig struct {
+	Repository string   `cnfrmz:"repository"`
+	Ref        string   `cnfrmz:"ref"`
+	Path       string   `cnfrmz:"path"`
+	Files      []string `cnfrmz:"files"`
+	Format     string   `cnfrmz:"format"`
+}
+
+// GitProvider reads files from a local git repository as they are at a revision,
+// without checking it out.
+type GitProvider struct {
+	alias      string
+	repository string
+	ref        string
+	path       string
+	files      []string
+	format     string
+}
+
+func New(alias string) *GitProvider {
+	return &GitProvider{alias: alias}
+}
+
+func (gitPrvdr *GitProvider) Alias() string {
+	return gitPrvdr.alias
+}
+
+func (gitPrvdr *GitProvider) ConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Configuration for the Git provider",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"repository": &attributes.StringAttribute{Description: "Path to a local repository, defaults to the working directory"},
+			"ref":        &attributes.StringAttribute{Description: "Branch, tag or commit to read the files at, defaults to HEAD"},
+			"path":       &attributes.StringAttribute{Description: "File to read, relative to the root of the repository"},
+			"files":      &attributes.ListAttribute{ElementsType: &typed.StringTyped{}, Description: "Files to read, relative to the root of the repository"},
+			"format":     &attributes.StringAttribute{Description: "Format of the files, inferred from their extension by default"},
+		},
+	}
+}
+
+func (gitPrvdr *GitProvider) Configure(req *sdk.ConfigurationRequest) error {
+	var config gitProviderConfig
+	if err := req.Get(&config); err != nil {
+		return err
+	}
+
+	if (len(config.Path) > 0) == (len(config.Files) > 0) {
+		return fmt.Errorf("exactly one of 'path' or 'files' must be specified")
+	}
+
+	if len(config.Repository) == 0 {
+		config.Repository = "."
+	}
+
+	repository, err := util.ResolveFilePath(config.Repository)
+	if err != nil {
+		return fmt.Errorf("couldn't resolve repository %s: %w", config.Repository, err)
+	}
+
+	if len(config.Ref) == 0 {
+		config.Ref = defaultRef
+	}
+
+	if strings.HasPrefix(config.Ref, "-") {
+		return fmt.Errorf("invalid ref '%s'", config.Ref)
+	}
+
+	gitPrvdr.repository = repository
+	gitPrvdr.ref = config.Ref
+	gitPrvdr.path = config.Path
+	gitPrvdr.files = config.Files
+	gitPrvdr.format = config.Format
+	return nil
+}
+
+func (gitPrvdr *GitProvider) ProvisionDataRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Git resource request schema",
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+}
+
+// Provide decodes the file at the configured ref as the root of the source, or,
+// when several files are configured, each one under its path.
+func (gitPrvdr *GitProvider) Provide(req *sdk.ProviderDataRequest) (*ds.Node[string, any], *diagnostics.Diagnostics) {
+	diags := diagnostics.NewDiagnostics()
+	commit, err := gitPrvdr.git("rev-parse", "--verify", "--quiet", gitPrvdr.ref+"^{commit}")
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("couldn't resolve ref '%s' in %s", gitPrvdr.ref, gitPrvdr.repository)).Build())
+		return nil, diags
+	}
+	commitSha := strings.TrimSpace(string(commit))
+
+	var root *ds.Node[string, any]
+	if len(gitPrvdr.path) > 0 {
+		if root, err = gitPrvdr.readFile(commitSha, gitPrvdr.path); err != nil {
+			diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+			return nil, diags
+		}
+	} else {
+		root = ds.NewNode[string, any]()
+		for _, filePath := range gitPrvdr.files {
+			fileNode, err := gitPrvdr.readFile(commitSha, filePath)
+			if err != nil {
+				diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+				return nil, diags
+			}
+			root.Append(filePath, fileNode)
+		}
+	}
+
+	root.AddAttribute("ref", gitPrvdr.ref)
+	root.AddAttribute("commit", commitSha)
+	return root, diags
+}
+
+func (gitPrvdr *GitProvider) readFile(commit string, filePath string) (*ds.Node[string, any], error) {
+	unmarshaller, err := unmarshal.ForFile(filePath, gitPrvdr.format)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := gitPrvdr.git("show", commit+":"+strings.TrimPrefix(filePath, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read %s at '%s': %w", filePath, gitPrvdr.ref, err)
+	}
+
+	data, err := unmarshaller.Unmarshal(serialization.NewBufferedData(content))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode %s at '%s': %w", filePath, gitPrvdr.ref, err)
+	}
+	return data, nil
+}
+
+func (gitPrvdr *GitProvider) git(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", gitPrvdr.repository}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); len(msg) > 0 {
+			return nil, fmt.Errorf("%s", msg)
+		}
+		return nil, err
+	}
+	return output, nil
+}
@@ -0,0 +1,173 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/conformize/conformize/internal/providers/api"
+)
+
+func initRepository(t *testing.T) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	repoDir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", repoDir}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %s", args, output)
+		}
+	}
+
+	write := func(name string, content string) {
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	write("app.yaml", "replicas: 2\n")
+	write("db.toml", "host = \"db.internal\"\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "initial")
+	run("tag", "v1")
+
+	write("app.yaml", "replicas: 5\n")
+	run("commit", "-q", "-am", "scale")
+	return repoDir
+}
+
+func TestGitProviderReadsFileAtRef(t *testing.T) {
+	repoDir := initRepository(t)
+
+	var testCases = []struct {
+		ref      string
+		expected any
+	}{
+		{ref: "", expected: 5},
+		{ref: "main", expected: 5},
+		{ref: "v1", expected: 2},
+		{ref: "HEAD~1", expected: 2},
+	}
+
+	for _, testCase := range testCases {
+		gitPrvdr := New("git")
+		cfgReq := sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+		cfgReq.SetAtPath("repository", repoDir)
+		cfgReq.SetAtPath("ref", testCase.ref)
+		cfgReq.SetAtPath("path", "app.yaml")
+		if err := gitPrvdr.Configure(cfgReq); err != nil {
+			t.Fatalf("Failed to configure git provider, reason: %s", err)
+		}
+
+		data, diags := gitPrvdr.Provide(sdk.NewProviderDataRequest(gitPrvdr.ProvisionDataRequestSchema()))
+		if diags.HasErrors() {
+			t.Fatalf("Failed to read app.yaml at '%s', reason: %s", testCase.ref, diags.Errors().String())
+		}
+
+		replicas, ok := data.GetChildren("replicas")
+		if !ok || replicas.First().Value != testCase.expected {
+			t.Errorf("Expected replicas at '%s' to be %v", testCase.ref, testCase.expected)
+		}
+
+		if _, ok := data.GetAttribute("commit"); !ok {
+			t.Errorf("Expected commit attribute at '%s'", testCase.ref)
+		}
+	}
+}
+
+func TestGitProviderReadsMultipleFiles(t *testing.T) {
+	repoDir := initRepository(t)
+
+	gitPrvdr := New("git")
+	cfgReq := sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("repository", repoDir)
+	cfgReq.SetAtPath("ref", "v1")
+	cfgReq.SetAtPath("files", []string{"app.yaml", "db.toml"})
+	if err := gitPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure git provider, reason: %s", err)
+	}
+
+	data, diags := gitPrvdr.Provide(sdk.NewProviderDataRequest(gitPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read files, reason: %s", diags.Errors().String())
+	}
+
+	for _, file := range []string{"app.yaml", "db.toml"} {
+		if _, ok := data.GetChildren(file); !ok {
+			t.Errorf("Expected data for %s", file)
+		}
+	}
+}
+
+func TestGitProviderReturnsErrorWithInvalidConfiguration(t *testing.T) {
+	repoDir := initRepository(t)
+
+	gitPrvdr := New("git")
+	cfgReq := sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("repository", repoDir)
+	if err := gitPrvdr.Configure(cfgReq); err == nil {
+		t.Errorf("Expected error without path or files")
+	}
+
+	cfgReq = sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("repository", repoDir)
+	cfgReq.SetAtPath("path", "app.yaml")
+	cfgReq.SetAtPath("files", []string{"db.toml"})
+	if err := gitPrvdr.Configure(cfgReq); err == nil {
+		t.Errorf("Expected error with both path and files")
+	}
+
+	cfgReq = sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("repository", repoDir)
+	cfgReq.SetAtPath("path", "app.yaml")
+	cfgReq.SetAtPath("ref", "--output=x")
+	if err := gitPrvdr.Configure(cfgReq); err == nil {
+		t.Errorf("Expected error with ref starting with a dash")
+	}
+}
+
+func TestGitProviderReturnsErrorWhenFileCantBeRead(t *testing.T) {
+	repoDir := initRepository(t)
+
+	gitPrvdr := New("git")
+	cfgReq := sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("repository", repoDir)
+	cfgReq.SetAtPath("path", "app.yaml")
+	cfgReq.SetAtPath("ref", "missing")
+	if err := gitPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure git provider, reason: %s", err)
+	}
+
+	if _, diags := gitPrvdr.Provide(sdk.NewProviderDataRequest(gitPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error with missing ref")
+	}
+
+	cfgReq = sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("repository", repoDir)
+	cfgReq.SetAtPath("path", "missing.yaml")
+	if err := gitPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure git provider, reason: %s", err)
+	}
+
+	if _, diags := gitPrvdr.Provide(sdk.NewProviderDataRequest(gitPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error with missing file")
+	}
+
+	cfgReq = sdk.NewConfigurationRequest(gitPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("repository", repoDir)
+	cfgReq.SetAtPath("path", "db.toml")
+	cfgReq.SetAtPath("format", "ini")
+	if err := gitPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure git provider, reason: %s", err)
+	}
+
+	if _, diags := gitPrvdr.Provide(sdk.NewProviderDataRequest(gitPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error with unsupported format")
+	}
+}
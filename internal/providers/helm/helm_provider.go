@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/internal/providers/kubernetes"
+)
+
+const defaultReleaseName = "release-name"
+
+type helmProviderConfig struct {
+	Chart              string            `cnfrmz:"chart"`
+	ValuesFiles        []string          `cnfrmz:"valuesFiles"`
+	Set                map[string]string `cnfrmz:"set"`
+	ReleaseName        string            `cnfrmz:"releaseName"`
+	Namespace          string            `cnfrmz:"namespace"`
+	ParseConfigMapData bool              `cnfrmz:"parseConfigMapData"`
+}
+
+// HelmProvider renders a local chart without access to a cluster and reads the rendered
+// resources, indexed by kind and name.
+type HelmProvider struct {
+	alias              string
+	chart              string
+	valuesFiles        []string
+	set                map[string]string
+	releaseName        string
+	namespace          string
+	parseConfigMapData bool
+}
+
+func New(alias string) *HelmProvider {
+	return &HelmProvider{alias: alias}
+}
+
+func (helmPrvdr *HelmProvider) Alias() string {
+	return helmPrvdr.alias
+}
+
+func (helmPrvdr *HelmProvider) ConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Configuration for the Helm provider",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"chart":              &attributes.StringAttribute{Required: true, Description: "Path to a local chart directory"},
+			"valuesFiles":        &attributes.ListAttribute{ElementsType: &typed.StringTyped{}, Description: "Values files to render the chart with, in order of precedence"},
+			"set":                &attributes.MapAttribute{ElementsType: &typed.StringTyped{}, Description: "Values to override, in the format of helm's --set"},
+			"releaseName":        &attributes.StringAttribute{Description: "Name of the release, defaults to release-name"},
+			"namespace":          &attributes.StringAttribute{Description: "Namespace of the release"},
+			"parseConfigMapData": &attributes.BooleanAttribute{Description: "Decode ConfigMap data entries named after files of a known format, e.g. app.yaml"},
+		},
+	}
+}
+
+func (helmPrvdr *HelmProvider) Configure(req *sdk.ConfigurationRequest) error {
+	var config helmProviderConfig
+	if err := req.Get(&config); err != nil {
+		return err
+	}
+
+	if len(config.Chart) == 0 {
+		return fmt.Errorf("chart must be specified")
+	}
+
+	chart, err := util.ResolveFilePath(config.Chart)
+	if err != nil {
+		return fmt.Errorf("couldn't resolve chart %s: %w", config.Chart, err)
+	}
+
+	valuesFiles := make([]string, 0, len(config.ValuesFiles))
+	for _, valuesFile := range config.ValuesFiles {
+		resolvedFile, err := util.ResolveFilePath(valuesFile)
+		if err != nil {
+			return fmt.Errorf("couldn't resolve values file %s: %w", valuesFile, err)
+		}
+		valuesFiles = append(valuesFiles, resolvedFile)
+	}
+
+	if len(config.ReleaseName) == 0 {
+		config.ReleaseName = defaultReleaseName
+	}
+
+	if strings.HasPrefix(config.ReleaseName, "-") || strings.HasPrefix(config.Namespace, "-") {
+		return fmt.Errorf("invalid release name '%s' or namespace '%s'", config.ReleaseName, config.Namespace)
+	}
+
+	helmPrvdr.chart = chart
+	helmPrvdr.valuesFiles = valuesFiles
+	helmPrvdr.set = config.Set
+	helmPrvdr.releaseName = config.ReleaseName
+	helmPrvdr.namespace = config.Namespace
+	helmPrvdr.parseConfigMapData = config.ParseConfigMapData
+	return nil
+}
+
+func (helmPrvdr *HelmProvider) ProvisionDataRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Helm resource request schema",
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+}
+
+// Provide renders the chart and places each resource under its kind and name,
+// e.g. $chart.'Deployment'.'api'.
+func (helmPrvdr *HelmProvider) Provide(req *sdk.ProviderDataRequest) (*ds.Node[string, any], *diagnostics.Diagnostics) {
+	diags := diagnostics.NewDiagnostics()
+	rendered, err := helmPrvdr.template()
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("couldn't render chart %s: %s", helmPrvdr.chart, err.Error())).Build())
+		return nil, diags
+	}
+
+	resources, err := kubernetes.DecodeManifests(rendered)
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("couldn't decode rendered chart %s: %s", helmPrvdr.chart, err.Error())).Build())
+		return nil, diags
+	}
+
+	index := kubernetes.NewResourceIndexByKind(helmPrvdr.parseConfigMapData)
+	for _, resource := range resources {
+		_, warnings, err := index.Add(resource)
+		if err != nil {
+			diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("rendered chart %s: %s", helmPrvdr.chart, err.Error())).Build())
+			return nil, diags
+		}
+
+		for _, warning := range warnings {
+			diags.Append(diagnostics.Builder().Warning().Details(warning).Build())
+		}
+	}
+
+	root := index.Root()
+	root.AddAttribute("release", helmPrvdr.releaseName)
+	return root, diags
+}
+
+// template renders the chart with helm's template command, which doesn't contact a cluster.
+func (helmPrvdr *HelmProvider) template() ([]byte, error) {
+	args := []string{"template", helmPrvdr.releaseName, helmPrvdr.chart}
+	if len(helmPrvdr.namespace) > 0 {
+		args = append(args, "--namespace", helmPrvdr.namespace)
+	}
+
+	for _, valuesFile := range helmPrvdr.valuesFiles {
+		args = append(args, "--values", valuesFile)
+	}
+
+	keys := make([]string, 0, len(helmPrvdr.set))
+	for key := range helmPrvdr.set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		args = append(args, "--set", key+"="+helmPrvdr.set[key])
+	}
+
+	cmd := exec.Command("helm", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); len(msg) > 0 {
+			return nil, fmt.Errorf("%s", msg)
+		}
+		return nil, err
+	}
+	return output, nil
+}
@@ -0,0 +1,156 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/conformize/conformize/internal/providers/api"
+)
+
+const rendered = `---
+# Source: app/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  replicas: 3
+---
+# Source: app/templates/canary.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api-canary
+  namespace: canary
+spec:
+  replicas: 1
+---
+# Source: app/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: api
+data:
+  token: c2VjcmV0
+`
+
+// stubHelm puts a helm executable on the PATH, which records its arguments and prints the given output.
+func stubHelm(t *testing.T, output string, exitCode int) string {
+	binDir := t.TempDir()
+	argsFile := filepath.Join(binDir, "args")
+	outputFile := filepath.Join(binDir, "output")
+	if err := os.WriteFile(outputFile, []byte(output), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	script := fmt.Sprintf("#!/bin/sh\nprintf '%%s\\n' \"$@\" > %s\ncat %s\nexit %d\n", argsFile, outputFile, exitCode)
+	if err := os.WriteFile(filepath.Join(binDir, "helm"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return argsFile
+}
+
+func TestHelmProviderIndexesRenderedResources(t *testing.T) {
+	argsFile := stubHelm(t, rendered, 0)
+	chartDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(chartDir, "values-prod.yaml"), []byte("replicas: 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	helmPrvdr := New("chart")
+	cfgReq := sdk.NewConfigurationRequest(helmPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("chart", chartDir)
+	cfgReq.SetAtPath("valuesFiles", []string{filepath.Join(chartDir, "values-prod.yaml")})
+	cfgReq.SetAtPath("set", map[string]string{"replicas": "3", "image.tag": "1.2.0"})
+	cfgReq.SetAtPath("namespace", "prod")
+	if err := helmPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure helm provider, reason: %s", err)
+	}
+
+	data, diags := helmPrvdr.Provide(sdk.NewProviderDataRequest(helmPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to render chart, reason: %s", diags.Errors().String())
+	}
+
+	args, _ := os.ReadFile(argsFile)
+	expectedArgs := strings.Join([]string{"template", "release-name", chartDir, "--namespace", "prod",
+		"--values", filepath.Join(chartDir, "values-prod.yaml"), "--set", "image.tag=1.2.0", "--set", "replicas=3"}, "\n") + "\n"
+	if string(args) != expectedArgs {
+		t.Errorf("Expected helm to be called with:\n%s\ngot:\n%s", expectedArgs, args)
+	}
+
+	deployments, found := data.GetChildren("Deployment")
+	if !found {
+		t.Fatalf("Expected rendered deployments")
+	}
+
+	api, found := deployments.First().GetChildren("api")
+	if !found {
+		t.Fatalf("Expected rendered deployment api")
+	}
+
+	spec, _ := api.First().GetChildren("spec")
+	if replicas, found := spec.First().GetChildren("replicas"); !found || replicas.First().Value != 3 {
+		t.Errorf("Expected rendered replicas")
+	}
+
+	if _, found := deployments.First().GetChildren("api-canary"); !found {
+		t.Errorf("Expected rendered deployment api-canary regardless of its namespace")
+	}
+
+	secrets, _ := data.GetChildren("Secret")
+	secret, _ := secrets.First().GetChildren("api")
+	secretData, _ := secret.First().GetChildren("data")
+	token, found := secretData.First().GetChildren("token")
+	if !found || token.First().Value != "secret" {
+		t.Errorf("Expected secret data to be decoded")
+	}
+}
+
+func TestHelmProviderReturnsErrorWithInvalidChart(t *testing.T) {
+	helmPrvdr := New("chart")
+	cfgReq := sdk.NewConfigurationRequest(helmPrvdr.ConfigurationSchema())
+	if err := helmPrvdr.Configure(cfgReq); err == nil {
+		t.Errorf("Expected error without chart")
+	}
+
+	cfgReq = sdk.NewConfigurationRequest(helmPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("chart", filepath.Join(t.TempDir(), "missing"))
+	if err := helmPrvdr.Configure(cfgReq); err == nil {
+		t.Errorf("Expected error with missing chart")
+	}
+}
+
+func TestHelmProviderReturnsErrorWhenRenderingFails(t *testing.T) {
+	stubHelm(t, "", 1)
+
+	helmPrvdr := New("chart")
+	cfgReq := sdk.NewConfigurationRequest(helmPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("chart", t.TempDir())
+	if err := helmPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure helm provider, reason: %s", err)
+	}
+
+	if _, diags := helmPrvdr.Provide(sdk.NewProviderDataRequest(helmPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error when helm fails")
+	}
+}
+
+func TestHelmProviderReturnsErrorWithDuplicateResources(t *testing.T) {
+	stubHelm(t, rendered+rendered, 0)
+
+	helmPrvdr := New("chart")
+	cfgReq := sdk.NewConfigurationRequest(helmPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("chart", t.TempDir())
+	if err := helmPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure helm provider, reason: %s", err)
+	}
+
+	if _, diags := helmPrvdr.Provide(sdk.NewProviderDataRequest(helmPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error with duplicate resources")
+	}
+}
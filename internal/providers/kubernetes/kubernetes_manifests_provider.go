@@ -0,0 +1,297 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/serialization"
+	"github.com/conformize/conformize/serialization/unmarshal"
+	unmarshalfns "github.com/conformize/conformize/serialization/unmarshal/functions"
+	"gopkg.in/yaml.v3"
+)
+
+var manifestExtensions = map[string]struct{}{".yaml": {}, ".yml": {}, ".json": {}}
+
+type manifestsProviderConfig struct {
+	Paths              []string `cnfrmz:"paths"`
+	ParseConfigMapData bool     `cnfrmz:"parseConfigMapData"`
+}
+
+// KubernetesManifestsProvider reads resources from manifest files into a ResourceIndex.
+type KubernetesManifestsProvider struct {
+	alias              string
+	paths              []string
+	parseConfigMapData bool
+}
+
+func New(alias string) *KubernetesManifestsProvider {
+	return &KubernetesManifestsProvider{alias: alias}
+}
+
+func (k8sPrvdr *KubernetesManifestsProvider) Alias() string {
+	return k8sPrvdr.alias
+}
+
+func (k8sPrvdr *KubernetesManifestsProvider) ConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Configuration for the Kubernetes manifests provider",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"paths": &attributes.ListAttribute{
+				ElementsType: &typed.StringTyped{},
+				Required:     true,
+				Description:  "Manifest files, or directories to read them from",
+			},
+			"parseConfigMapData": &attributes.BooleanAttribute{
+				Description: "Decode ConfigMap data entries named after files of a known format, e.g. app.yaml",
+			},
+		},
+	}
+}
+
+func (k8sPrvdr *KubernetesManifestsProvider) Configure(req *sdk.ConfigurationRequest) error {
+	var config manifestsProviderConfig
+	if err := req.Get(&config); err != nil {
+		return err
+	}
+
+	if len(config.Paths) == 0 {
+		return fmt.Errorf("at least one path must be specified")
+	}
+
+	k8sPrvdr.paths = config.Paths
+	k8sPrvdr.parseConfigMapData = config.ParseConfigMapData
+	return nil
+}
+
+func (k8sPrvdr *KubernetesManifestsProvider) ProvisionDataRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Kubernetes manifests resource request schema",
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+}
+
+func (k8sPrvdr *KubernetesManifestsProvider) Provide(req *sdk.ProviderDataRequest) (*ds.Node[string, any], *diagnostics.Diagnostics) {
+	diags := diagnostics.NewDiagnostics()
+	files, err := k8sPrvdr.manifestFiles()
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+		return nil, diags
+	}
+
+	index := NewResourceIndex(k8sPrvdr.parseConfigMapData)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+			return nil, diags
+		}
+
+		resources, err := DecodeManifests(content)
+		if err != nil {
+			diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("couldn't decode %s: %s", file, err.Error())).Build())
+			return nil, diags
+		}
+
+		for _, resource := range resources {
+			resourceNode, warnings, err := index.Add(resource)
+			if err != nil {
+				diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("%s: %s", file, err.Error())).Build())
+				return nil, diags
+			}
+
+			for _, warning := range warnings {
+				diags.Append(diagnostics.Builder().Warning().Details(fmt.Sprintf("%s: %s", file, warning)).Build())
+			}
+			resourceNode.AddAttribute("file", file)
+		}
+	}
+	return index.Root(), diags
+}
+
+// manifestFiles lists the configured files, and the manifests found in the configured directories.
+func (k8sPrvdr *KubernetesManifestsProvider) manifestFiles() ([]string, error) {
+	var files []string
+	for _, manifestsPath := range k8sPrvdr.paths {
+		resolvedPath, err := util.ResolveFilePath(manifestsPath)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't resolve %s: %w", manifestsPath, err)
+		}
+
+		info, err := os.Stat(resolvedPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, resolvedPath)
+			continue
+		}
+
+		err = filepath.WalkDir(resolvedPath, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+
+			if _, isManifest := manifestExtensions[strings.ToLower(filepath.Ext(filePath))]; isManifest {
+				files = append(files, filePath)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// DecodeManifests decodes the documents of a manifest file, expanding lists of resources.
+func DecodeManifests(content []byte) ([]map[string]any, error) {
+	var resources []map[string]any
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc map[string]any
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return resources, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if doc == nil {
+			continue
+		}
+
+		kind, _ := doc["kind"].(string)
+		items, isList := doc["items"].([]any)
+		if !isList || !strings.HasSuffix(kind, "List") {
+			resources = append(resources, doc)
+			continue
+		}
+
+		for idx, item := range items {
+			resource, isResource := item.(map[string]any)
+			if !isResource {
+				return nil, fmt.Errorf("item %d of %s is not a resource", idx, kind)
+			}
+			resources = append(resources, resource)
+		}
+	}
+}
+
+// ResourceNode converts a resource to a node, decoding the data of Secrets and, when requested,
+// the embedded config files of ConfigMaps. Problems decoding the data are returned as warnings.
+func ResourceNode(resource map[string]any, parseConfigMapData bool) (*ds.Node[string, any], []string) {
+	var warnings []string
+	var decodedData map[string]*ds.Node[string, any]
+	switch resource["kind"] {
+	case "Secret":
+		warnings = decodeSecretData(resource)
+	case "ConfigMap":
+		if parseConfigMapData {
+			decodedData, warnings = decodeConfigMapData(resource)
+		}
+	}
+
+	resourceNode := ds.NewNode[string, any]()
+	unmarshalfns.UnmarshalValue(resourceNode, resource)
+
+	dataNodes, hasData := resourceNode.GetChildren("data")
+	if resource["kind"] == "Secret" {
+		for _, field := range []string{"data", "stringData"} {
+			if fieldNodes, found := resourceNode.GetChildren(field); found {
+				for _, valueNodes := range fieldNodes.First().Children() {
+					valueNodes.First().AddAttribute(sdk.SensitiveAttribute, true)
+				}
+			}
+		}
+	}
+
+	if hasData {
+		for key, decoded := range decodedData {
+			dataNodes.First().Append(key, decoded)
+		}
+	}
+	return resourceNode, warnings
+}
+
+// decodeSecretData decodes the base64 encoded values of a Secret and merges its stringData into them,
+// the way the API server does.
+func decodeSecretData(secret map[string]any) []string {
+	var warnings []string
+	data, _ := secret["data"].(map[string]any)
+	decoded := make(map[string]any, len(data))
+	for key, value := range data {
+		encoded, _ := value.(string)
+		decodedValue, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("data '%s' is not base64 encoded", key))
+			decoded[key] = value
+			continue
+		}
+		decoded[key] = string(decodedValue)
+	}
+
+	stringData, _ := secret["stringData"].(map[string]any)
+	for key, value := range stringData {
+		decoded[key] = value
+	}
+
+	if len(decoded) > 0 {
+		secret["data"] = decoded
+	}
+	return warnings
+}
+
+// decodeConfigMapData decodes the data entries of a ConfigMap named after files of a known format,
+// removing them from the resource. Entries which fail to decode are kept as they are.
+func decodeConfigMapData(configMap map[string]any) (map[string]*ds.Node[string, any], []string) {
+	var warnings []string
+	data, _ := configMap["data"].(map[string]any)
+	decoded := make(map[string]*ds.Node[string, any])
+	for key, value := range data {
+		content, isString := value.(string)
+		if !isString {
+			continue
+		}
+
+		unmarshaller, err := unmarshal.ForFile(key, "")
+		if err != nil {
+			continue
+		}
+
+		decodedNode, err := unmarshaller.Unmarshal(serialization.NewBufferedData([]byte(content)))
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("couldn't decode data '%s': %s", key, err.Error()))
+			continue
+		}
+		decoded[key] = decodedNode
+		delete(data, key)
+	}
+	return decoded, warnings
+}
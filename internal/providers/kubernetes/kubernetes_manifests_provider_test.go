@@ -0,0 +1,147 @@
+package kubernetes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/conformize/conformize/internal/providers/api"
+)
+
+const manifests = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app
+  namespace: prod
+data:
+  LOG_LEVEL: info
+  app.yaml: |
+    database:
+      host: db.internal
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: db
+  namespace: prod
+data:
+  password: aHVudGVyMg==
+stringData:
+  username: admin
+---
+apiVersion: v1
+kind: List
+items:
+  - apiVersion: v1
+    kind: Namespace
+    metadata:
+      name: prod
+`
+
+func writeManifests(t *testing.T, files map[string]string) string {
+	manifestsDir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(manifestsDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return manifestsDir
+}
+
+func TestKubernetesManifestsProviderIndexesResources(t *testing.T) {
+	k8sPrvdr := New("manifests")
+	cfgReq := sdk.NewConfigurationRequest(k8sPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("paths", []string{writeManifests(t, map[string]string{"app.yaml": manifests, "README.md": "not a manifest"})})
+	if err := k8sPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure provider, reason: %s", err)
+	}
+
+	data, diags := k8sPrvdr.Provide(sdk.NewProviderDataRequest(k8sPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read manifests, reason: %s", diags.Errors().String())
+	}
+
+	for _, key := range []string{"ConfigMap/prod/app", "Secret/prod/db", "Namespace/prod"} {
+		if _, found := data.GetChildren(key); !found {
+			t.Errorf("Expected resource %s", key)
+		}
+	}
+
+	configMap, _ := data.GetChildren("ConfigMap/prod/app")
+	configMapData, _ := configMap.First().GetChildren("data")
+	if logLevel, found := configMapData.First().GetChildren("LOG_LEVEL"); !found || logLevel.First().Value != "info" {
+		t.Errorf("Expected ConfigMap data to be kept as is")
+	}
+
+	if appConfig, found := configMapData.First().GetChildren("app.yaml"); !found || appConfig.First().Value == nil {
+		t.Errorf("Expected embedded config to be kept as a string")
+	}
+}
+
+func TestKubernetesManifestsProviderDecodesSecretData(t *testing.T) {
+	k8sPrvdr := New("manifests")
+	cfgReq := sdk.NewConfigurationRequest(k8sPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("paths", []string{writeManifests(t, map[string]string{"app.yaml": manifests})})
+	if err := k8sPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure provider, reason: %s", err)
+	}
+
+	data, diags := k8sPrvdr.Provide(sdk.NewProviderDataRequest(k8sPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read manifests, reason: %s", diags.Errors().String())
+	}
+
+	secret, _ := data.GetChildren("Secret/prod/db")
+	secretData, _ := secret.First().GetChildren("data")
+	for key, expected := range map[string]string{"password": "hunter2", "username": "admin"} {
+		secretValue, found := secretData.First().GetChildren(key)
+		if !found || secretValue.First().Value != expected {
+			t.Errorf("Expected secret %s to be decoded", key)
+			continue
+		}
+
+		if attr, found := secretValue.First().GetAttribute(sdk.SensitiveAttribute); !found || attr.Value != true {
+			t.Errorf("Expected secret %s to be marked sensitive", key)
+		}
+	}
+}
+
+func TestKubernetesManifestsProviderParsesConfigMapData(t *testing.T) {
+	k8sPrvdr := New("manifests")
+	cfgReq := sdk.NewConfigurationRequest(k8sPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("paths", []string{writeManifests(t, map[string]string{"app.yaml": manifests})})
+	cfgReq.SetAtPath("parseConfigMapData", true)
+	if err := k8sPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure provider, reason: %s", err)
+	}
+
+	data, diags := k8sPrvdr.Provide(sdk.NewProviderDataRequest(k8sPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read manifests, reason: %s", diags.Errors().String())
+	}
+
+	configMap, _ := data.GetChildren("ConfigMap/prod/app")
+	configMapData, _ := configMap.First().GetChildren("data")
+	appConfig, _ := configMapData.First().GetChildren("app.yaml")
+	database, found := appConfig.First().GetChildren("database")
+	if !found {
+		t.Fatalf("Expected embedded config to be decoded")
+	}
+
+	if host, found := database.First().GetChildren("host"); !found || host.First().Value != "db.internal" {
+		t.Errorf("Expected embedded config to be decoded")
+	}
+}
+
+func TestKubernetesManifestsProviderReturnsErrorWithDuplicateResources(t *testing.T) {
+	k8sPrvdr := New("manifests")
+	cfgReq := sdk.NewConfigurationRequest(k8sPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("paths", []string{writeManifests(t, map[string]string{"a.yaml": manifests, "b.yml": manifests})})
+	if err := k8sPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure provider, reason: %s", err)
+	}
+
+	if _, diags := k8sPrvdr.Provide(sdk.NewProviderDataRequest(k8sPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+		t.Errorf("Expected error with duplicate resources")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/conformize/conformize/common/ds"
+)
+
+// ResourceIndex places resources under the keys identifying them. By default a resource
+// is placed under a flat kind/namespace/name key, or kind/name when it has no namespace,
+// e.g. 'Deployment/prod/api' or 'ClusterRole/viewer'.
+type ResourceIndex struct {
+	root               *ds.Node[string, any]
+	parseConfigMapData bool
+	keysOf             func(resource map[string]any) ([]string, error)
+}
+
+func NewResourceIndex(parseConfigMapData bool) *ResourceIndex {
+	return &ResourceIndex{root: ds.NewNode[string, any](), parseConfigMapData: parseConfigMapData, keysOf: resourceKey}
+}
+
+// NewResourceIndexByKind creates an index placing resources under their kind and then their
+// name, e.g. 'Deployment'.'api', regardless of their namespace.
+func NewResourceIndexByKind(parseConfigMapData bool) *ResourceIndex {
+	return &ResourceIndex{root: ds.NewNode[string, any](), parseConfigMapData: parseConfigMapData, keysOf: kindAndName}
+}
+
+// Add converts a resource to a node and indexes it, failing when its key is already taken.
+// Problems decoding the resource's data are returned as warnings prefixed with its key.
+func (index *ResourceIndex) Add(resource map[string]any) (*ds.Node[string, any], []string, error) {
+	keys, err := index.keysOf(resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := index.root
+	for _, key := range keys[:len(keys)-1] {
+		if children, found := parent.GetChildren(key); found {
+			parent = children.First()
+			continue
+		}
+
+		node := ds.NewNode[string, any]()
+		parent.Append(key, node)
+		parent = node
+	}
+
+	key := strings.Join(keys, "/")
+	if _, found := parent.GetChildren(keys[len(keys)-1]); found {
+		return nil, nil, fmt.Errorf("duplicate resource %s", key)
+	}
+
+	resourceNode, warnings := ResourceNode(resource, index.parseConfigMapData)
+	for idx, warning := range warnings {
+		warnings[idx] = key + ": " + warning
+	}
+	parent.Append(keys[len(keys)-1], resourceNode)
+	return resourceNode, warnings, nil
+}
+
+func (index *ResourceIndex) Root() *ds.Node[string, any] {
+	return index.root
+}
+
+// resourceKey identifies a resource as kind/namespace/name, or kind/name when it has no namespace.
+func resourceKey(resource map[string]any) ([]string, error) {
+	keys, err := kindAndName(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, _ := resource["metadata"].(map[string]any)
+	if namespace, _ := metadata["namespace"].(string); len(namespace) > 0 {
+		return []string{keys[0] + "/" + namespace + "/" + keys[1]}, nil
+	}
+	return []string{keys[0] + "/" + keys[1]}, nil
+}
+
+func kindAndName(resource map[string]any) ([]string, error) {
+	kind, _ := resource["kind"].(string)
+	metadata, _ := resource["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	if len(kind) == 0 || len(name) == 0 {
+		return nil, fmt.Errorf("resource without kind or metadata.name")
+	}
+	return []string{kind, name}, nil
+}
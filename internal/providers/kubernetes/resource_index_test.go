@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+)
+
+func resource(kind, namespace, name string) map[string]any {
+	metadata := map[string]any{"name": name}
+	if len(namespace) > 0 {
+		metadata["namespace"] = namespace
+	}
+	return map[string]any{"kind": kind, "metadata": metadata}
+}
+
+func TestResourceIndexKeysResourcesByKindNamespaceAndName(t *testing.T) {
+	index := NewResourceIndex(false)
+	resources := []map[string]any{
+		resource("Deployment", "prod", "api"),
+		resource("Deployment", "staging", "api"),
+		resource("ClusterRole", "", "viewer"),
+	}
+
+	for _, res := range resources {
+		if _, _, err := index.Add(res); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+
+	for _, key := range []string{"Deployment/prod/api", "Deployment/staging/api", "ClusterRole/viewer"} {
+		if _, found := index.Root().GetChildren(key); !found {
+			t.Errorf("Expected resource %s", key)
+		}
+	}
+
+	if _, _, err := index.Add(resource("Deployment", "prod", "api")); err == nil {
+		t.Errorf("Expected error for duplicate resource")
+	}
+
+	if _, _, err := index.Add(resource("Deployment", "prod", "")); err == nil {
+		t.Errorf("Expected error for resource without name")
+	}
+}
+
+func TestResourceIndexByKindPlacesResourcesUnderKindAndName(t *testing.T) {
+	index := NewResourceIndexByKind(false)
+	resources := []map[string]any{
+		resource("Deployment", "", "api"),
+		resource("Deployment", "canary", "api-canary"),
+		resource("Secret", "", "api"),
+	}
+
+	for _, res := range resources {
+		if _, _, err := index.Add(res); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+
+	deployments, found := index.Root().GetChildren("Deployment")
+	if !found || len(deployments.First().Children()) != 2 {
+		t.Fatalf("Expected deployments api and api-canary")
+	}
+
+	if _, found := deployments.First().GetChildren("api-canary"); !found {
+		t.Errorf("Expected deployment api-canary regardless of its namespace")
+	}
+
+	if _, _, err := index.Add(resource("Deployment", "prod", "api")); err == nil {
+		t.Errorf("Expected error for duplicate resource")
+	}
+}
+
+func TestResourceIndexPrefixesWarningsWithResourceKey(t *testing.T) {
+	secret := resource("Secret", "prod", "db")
+	secret["data"] = map[string]any{"password": "not base64!"}
+
+	_, warnings, err := NewResourceIndex(false).Add(secret)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	if len(warnings) != 1 || !strings.HasPrefix(warnings[0], "Secret/prod/db: ") {
+		t.Errorf("Expected a warning prefixed with the resource key, got %v", warnings)
+	}
+}
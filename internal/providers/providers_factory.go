@@ -13,16 +13,22 @@ import (
 
 	"github.com/conformize/conformize/internal/providers/aggregate"
 	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/archive"
 	"github.com/conformize/conformize/internal/providers/awsparameterstore"
 	"github.com/conformize/conformize/internal/providers/azuredevopsvariablegroup"
 	"github.com/conformize/conformize/internal/providers/azurekeyvault"
+	"github.com/conformize/conformize/internal/providers/compose"
 	"github.com/conformize/conformize/internal/providers/consul"
 	environment "github.com/conformize/conformize/internal/providers/env"
 	"github.com/conformize/conformize/internal/providers/etcd"
 	"github.com/conformize/conformize/internal/providers/file"
+	"github.com/conformize/conformize/internal/providers/git"
 	"github.com/conformize/conformize/internal/providers/googlesecretmanager"
+	"github.com/conformize/conformize/internal/providers/helm"
 	"github.com/conformize/conformize/internal/providers/http"
+	"github.com/conformize/conformize/internal/providers/kubernetes"
 	"github.com/conformize/conformize/internal/providers/secretsmanager"
+	"github.com/conformize/conformize/internal/providers/terraform"
 	"github.com/conformize/conformize/internal/providers/vault"
 	"github.com/conformize/conformize/serialization/unmarshal/env"
 	"github.com/conformize/conformize/serialization/unmarshal/hcl"
@@ -53,6 +59,13 @@ const (
 	Http                     ProviderName = "http"
 	HCL                      ProviderName = "hcl"
 	Aggregate                ProviderName = "aggregate"
+	Git                      ProviderName = "git"
+	KubernetesManifests      ProviderName = "kubernetes_manifests"
+	Helm                     ProviderName = "helm"
+	Terraform                ProviderName = "terraform"
+	Compose                  ProviderName = "compose"
+	Files                    ProviderName = "files"
+	Archive                  ProviderName = "archive"
 )
 
 var supportedProviders = map[ProviderName]providerFactoryFn{
@@ -108,6 +121,23 @@ var supportedProviders = map[ProviderName]providerFactoryFn{
 			initCtx.ValueReferencesStore, initCtx.ProvidersRegistry, initCtx.ProvidersDependenciesGraph,
 		)
 	},
+	Git: func(initCtx *ProviderInitializationContext) sdk.ConfigurationProvider { return git.New(initCtx.Alias) },
+	KubernetesManifests: func(initCtx *ProviderInitializationContext) sdk.ConfigurationProvider {
+		return kubernetes.New(initCtx.Alias)
+	},
+	Helm: func(initCtx *ProviderInitializationContext) sdk.ConfigurationProvider { return helm.New(initCtx.Alias) },
+	Terraform: func(initCtx *ProviderInitializationContext) sdk.ConfigurationProvider {
+		return terraform.New(initCtx.Alias)
+	},
+	Compose: func(initCtx *ProviderInitializationContext) sdk.ConfigurationProvider {
+		return compose.New(initCtx.Alias)
+	},
+	Files: func(initCtx *ProviderInitializationContext) sdk.ConfigurationProvider {
+		return file.NewFilesProvider(initCtx.Alias)
+	},
+	Archive: func(initCtx *ProviderInitializationContext) sdk.ConfigurationProvider {
+		return archive.New(initCtx.Alias)
+	},
 }
 
 func (pn ProviderName) build(ctx *ProviderInitializationContext) (sdk.ConfigurationProvider, error) {
@@ -0,0 +1,253 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package terraform
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/util"
+	sdk "github.com/conformize/conformize/internal/providers/api"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	unmarshalfns "github.com/conformize/conformize/serialization/unmarshal/functions"
+	"gopkg.in/yaml.v3"
+)
+
+// resourceFields are the fields of a resource kept besides its values.
+var resourceFields = []string{"mode", "type", "name", "index", "provider_name"}
+
+type terraformProviderConfig struct {
+	Path string `cnfrmz:"path"`
+}
+
+// TerraformProvider reads the resources of a plan or a state, as output by terraform show -json,
+// indexed by their address.
+type TerraformProvider struct {
+	alias string
+	path  string
+}
+
+func New(alias string) *TerraformProvider {
+	return &TerraformProvider{alias: alias}
+}
+
+func (tfPrvdr *TerraformProvider) Alias() string {
+	return tfPrvdr.alias
+}
+
+func (tfPrvdr *TerraformProvider) ConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Configuration for the Terraform provider",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"path": &attributes.StringAttribute{Required: true, Description: "Path to the output of terraform show -json for a plan or a state"},
+		},
+	}
+}
+
+func (tfPrvdr *TerraformProvider) Configure(req *sdk.ConfigurationRequest) error {
+	var config terraformProviderConfig
+	if err := req.Get(&config); err != nil {
+		return err
+	}
+
+	if len(config.Path) == 0 {
+		return fmt.Errorf("path must be specified")
+	}
+
+	path, err := util.ResolveFilePath(config.Path)
+	if err != nil {
+		return fmt.Errorf("couldn't resolve %s: %w", config.Path, err)
+	}
+
+	tfPrvdr.path = path
+	return nil
+}
+
+func (tfPrvdr *TerraformProvider) ProvisionDataRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Terraform resource request schema",
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+}
+
+// Provide places each resource under its address, e.g. $tf.'aws_s3_bucket.logs'.'values'.
+// Resources of a plan carry their planned values, and the actions, before and after of their change.
+func (tfPrvdr *TerraformProvider) Provide(req *sdk.ProviderDataRequest) (*ds.Node[string, any], *diagnostics.Diagnostics) {
+	diags := diagnostics.NewDiagnostics()
+	content, err := os.ReadFile(tfPrvdr.path)
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(err.Error()).Build())
+		return nil, diags
+	}
+
+	var output map[string]any
+	if err := yaml.Unmarshal(content, &output); err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("couldn't decode %s: %s", tfPrvdr.path, err.Error())).Build())
+		return nil, diags
+	}
+
+	resources, err := outputResources(output)
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Details(fmt.Sprintf("%s: %s", tfPrvdr.path, err.Error())).Build())
+		return nil, diags
+	}
+
+	root := ds.NewNode[string, any]()
+	for _, resource := range resources {
+		root.Append(resource.address, resource.node())
+	}
+
+	if version, ok := output["terraform_version"].(string); ok {
+		root.AddAttribute("terraform_version", version)
+	}
+	return root, diags
+}
+
+type resource struct {
+	address   string
+	fields    map[string]any
+	sensitive map[string]any
+}
+
+// node converts the resource to a node, marking the values terraform reported as sensitive.
+func (res *resource) node() *ds.Node[string, any] {
+	resourceNode := ds.NewNode[string, any]()
+	unmarshalfns.UnmarshalValue(resourceNode, res.fields)
+	for field, sensitive := range res.sensitive {
+		if fieldNodes, found := resourceNode.GetChildren(field); found {
+			markSensitive(fieldNodes.First(), sensitive)
+		}
+	}
+	return resourceNode
+}
+
+// outputResources collects the resources of a plan, or of a state when the output has no planned values.
+func outputResources(output map[string]any) ([]*resource, error) {
+	plannedValues, isPlan := output["planned_values"].(map[string]any)
+	changes, hasChanges := output["resource_changes"].([]any)
+	if !isPlan && !hasChanges {
+		values, isState := output["values"].(map[string]any)
+		if !isState {
+			if _, hasFormat := output["format_version"]; !hasFormat {
+				return nil, fmt.Errorf("not the output of terraform show -json")
+			}
+			return nil, nil
+		}
+		rootModule, _ := values["root_module"].(map[string]any)
+		return moduleResources(rootModule), nil
+	}
+
+	plannedModule, _ := plannedValues["root_module"].(map[string]any)
+	resources := moduleResources(plannedModule)
+	resourcesByAddress := make(map[string]*resource, len(resources))
+	for _, res := range resources {
+		resourcesByAddress[res.address] = res
+	}
+
+	for _, item := range changes {
+		resourceChange, _ := item.(map[string]any)
+		address, _ := resourceChange["address"].(string)
+		if len(address) == 0 {
+			continue
+		}
+
+		res, found := resourcesByAddress[address]
+		if !found {
+			res = newResource(resourceChange)
+			resourcesByAddress[address] = res
+			resources = append(resources, res)
+		}
+
+		change, _ := resourceChange["change"].(map[string]any)
+		res.fields["actions"] = change["actions"]
+		res.fields["before"] = change["before"]
+		res.fields["after"] = change["after"]
+		res.sensitive["before"] = change["before_sensitive"]
+		res.sensitive["after"] = change["after_sensitive"]
+	}
+	return resources, nil
+}
+
+// moduleResources collects the resources of a module and of its child modules.
+func moduleResources(module map[string]any) []*resource {
+	var resources []*resource
+	items, _ := module["resources"].([]any)
+	for _, item := range items {
+		if moduleResource, ok := item.(map[string]any); ok {
+			res := newResource(moduleResource)
+			res.fields["values"] = moduleResource["values"]
+			res.sensitive["values"] = moduleResource["sensitive_values"]
+			resources = append(resources, res)
+		}
+	}
+
+	childModules, _ := module["child_modules"].([]any)
+	for _, childModule := range childModules {
+		if child, ok := childModule.(map[string]any); ok {
+			resources = append(resources, moduleResources(child)...)
+		}
+	}
+	return resources
+}
+
+func newResource(item map[string]any) *resource {
+	address, _ := item["address"].(string)
+	fields := make(map[string]any)
+	for _, field := range resourceFields {
+		if value, found := item[field]; found {
+			fields[field] = value
+		}
+	}
+	return &resource{address: address, fields: fields, sensitive: make(map[string]any)}
+}
+
+// markSensitive marks the nodes terraform reported as sensitive. Lists are kept as values,
+// so a list holding a sensitive element is marked as a whole.
+func markSensitive(node *ds.Node[string, any], sensitive any) {
+	switch sensitiveValue := sensitive.(type) {
+	case bool:
+		if sensitiveValue {
+			node.AddAttribute(sdk.SensitiveAttribute, true)
+		}
+	case map[string]any:
+		for key, sensitiveField := range sensitiveValue {
+			if children, found := node.GetChildren(key); found {
+				markSensitive(children.First(), sensitiveField)
+			}
+		}
+	case []any:
+		if hasSensitive(sensitiveValue) {
+			node.AddAttribute(sdk.SensitiveAttribute, true)
+		}
+	}
+}
+
+func hasSensitive(sensitive any) bool {
+	switch sensitiveValue := sensitive.(type) {
+	case bool:
+		return sensitiveValue
+	case map[string]any:
+		for _, sensitiveField := range sensitiveValue {
+			if hasSensitive(sensitiveField) {
+				return true
+			}
+		}
+	case []any:
+		for _, sensitiveItem := range sensitiveValue {
+			if hasSensitive(sensitiveItem) {
+				return true
+			}
+		}
+	}
+	return false
+}
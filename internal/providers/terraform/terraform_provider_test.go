@@ -0,0 +1,244 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/conformize/conformize/internal/providers/api"
+)
+
+const plan = `{
+  "format_version": "1.2",
+  "terraform_version": "1.9.5",
+  "planned_values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_s3_bucket.logs",
+          "mode": "managed",
+          "type": "aws_s3_bucket",
+          "name": "logs",
+          "provider_name": "registry.terraform.io/hashicorp/aws",
+          "values": {"bucket": "logs", "force_destroy": false, "tags": null},
+          "sensitive_values": {}
+        }
+      ],
+      "child_modules": [
+        {
+          "address": "module.db",
+          "resources": [
+            {
+              "address": "module.db.aws_db_instance.main",
+              "mode": "managed",
+              "type": "aws_db_instance",
+              "name": "main",
+              "values": {"password": "hunter2", "instance_class": "db.t3.micro"},
+              "sensitive_values": {"password": true}
+            }
+          ]
+        }
+      ]
+    }
+  },
+  "resource_changes": [
+    {
+      "address": "aws_s3_bucket.logs",
+      "change": {
+        "actions": ["update"],
+        "before": {"bucket": "logs", "force_destroy": true, "tags": null},
+        "after": {"bucket": "logs", "force_destroy": false, "tags": null},
+        "before_sensitive": {},
+        "after_sensitive": {}
+      }
+    },
+    {
+      "address": "aws_instance.legacy",
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "legacy",
+      "change": {
+        "actions": ["delete"],
+        "before": {"instance_type": "t2.micro"},
+        "after": null,
+        "before_sensitive": {},
+        "after_sensitive": false
+      }
+    }
+  ]
+}`
+
+const state = `{
+  "format_version": "1.0",
+  "terraform_version": "1.9.5",
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_s3_bucket.logs",
+          "mode": "managed",
+          "type": "aws_s3_bucket",
+          "name": "logs",
+          "values": {"bucket": "logs", "tags": {"team": "platform"}},
+          "sensitive_values": {"tags": {}}
+        }
+      ]
+    }
+  }
+}`
+
+func writeOutput(t *testing.T, content string) string {
+	outputFile := filepath.Join(t.TempDir(), "output.json")
+	if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return outputFile
+}
+
+func TestTerraformProviderReadsPlan(t *testing.T) {
+	tfPrvdr := New("tf")
+	cfgReq := sdk.NewConfigurationRequest(tfPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("path", writeOutput(t, plan))
+	if err := tfPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure terraform provider, reason: %s", err)
+	}
+
+	data, diags := tfPrvdr.Provide(sdk.NewProviderDataRequest(tfPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read plan, reason: %s", diags.Errors().String())
+	}
+
+	bucket, found := data.GetChildren("aws_s3_bucket.logs")
+	if !found {
+		t.Fatalf("Expected resource aws_s3_bucket.logs")
+	}
+
+	if resourceType, _ := bucket.First().GetChildren("type"); resourceType.First().Value != "aws_s3_bucket" {
+		t.Errorf("Expected resource type aws_s3_bucket")
+	}
+
+	bucketValues, _ := bucket.First().GetChildren("values")
+	if bucketName, found := bucketValues.First().GetChildren("bucket"); !found || bucketName.First().Value != "logs" {
+		t.Errorf("Expected planned values of aws_s3_bucket.logs")
+	}
+
+	before, _ := bucket.First().GetChildren("before")
+	if forceDestroy, found := before.First().GetChildren("force_destroy"); !found || forceDestroy.First().Value != true {
+		t.Errorf("Expected values of aws_s3_bucket.logs before the change")
+	}
+
+	after, _ := bucket.First().GetChildren("after")
+	if forceDestroy, found := after.First().GetChildren("force_destroy"); !found || forceDestroy.First().Value != false {
+		t.Errorf("Expected values of aws_s3_bucket.logs after the change")
+	}
+
+	dbInstance, found := data.GetChildren("module.db.aws_db_instance.main")
+	if !found {
+		t.Fatalf("Expected resource module.db.aws_db_instance.main")
+	}
+
+	dbValues, _ := dbInstance.First().GetChildren("values")
+	if instanceClass, found := dbValues.First().GetChildren("instance_class"); !found || instanceClass.First().Value != "db.t3.micro" {
+		t.Errorf("Expected planned values of module.db.aws_db_instance.main")
+	}
+
+	legacy, found := data.GetChildren("aws_instance.legacy")
+	if !found {
+		t.Fatalf("Expected resource aws_instance.legacy")
+	}
+
+	legacyBefore, _ := legacy.First().GetChildren("before")
+	if instanceType, found := legacyBefore.First().GetChildren("instance_type"); !found || instanceType.First().Value != "t2.micro" {
+		t.Errorf("Expected values of aws_instance.legacy before the change")
+	}
+
+	if legacyAfter, found := legacy.First().GetChildren("after"); !found || legacyAfter.First().Value != nil {
+		t.Errorf("Expected no values of aws_instance.legacy after the change")
+	}
+
+	if actions, _ := legacy.First().GetChildren("actions"); fmt.Sprint(actions.First().Value) != "[delete]" {
+		t.Errorf("Expected delete action, got %v", actions.First().Value)
+	}
+
+	if version, found := data.GetAttribute("terraform_version"); !found || version.Value != "1.9.5" {
+		t.Errorf("Expected terraform version attribute")
+	}
+}
+
+func TestTerraformProviderMarksSensitiveValues(t *testing.T) {
+	tfPrvdr := New("tf")
+	cfgReq := sdk.NewConfigurationRequest(tfPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("path", writeOutput(t, plan))
+	if err := tfPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure terraform provider, reason: %s", err)
+	}
+
+	data, diags := tfPrvdr.Provide(sdk.NewProviderDataRequest(tfPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read plan, reason: %s", diags.Errors().String())
+	}
+
+	dbInstance, _ := data.GetChildren("module.db.aws_db_instance.main")
+	dbValues, _ := dbInstance.First().GetChildren("values")
+	password, _ := dbValues.First().GetChildren("password")
+	if _, sensitive := password.First().GetAttribute(sdk.SensitiveAttribute); !sensitive {
+		t.Errorf("Expected password to be sensitive")
+	}
+
+	instanceClass, _ := dbValues.First().GetChildren("instance_class")
+	if _, sensitive := instanceClass.First().GetAttribute(sdk.SensitiveAttribute); sensitive {
+		t.Errorf("Expected instance_class not to be sensitive")
+	}
+
+	bucket, _ := data.GetChildren("aws_s3_bucket.logs")
+	bucketValues, _ := bucket.First().GetChildren("values")
+	bucketName, _ := bucketValues.First().GetChildren("bucket")
+	if _, sensitive := bucketName.First().GetAttribute(sdk.SensitiveAttribute); sensitive {
+		t.Errorf("Expected bucket not to be sensitive")
+	}
+}
+
+func TestTerraformProviderReadsState(t *testing.T) {
+	tfPrvdr := New("tf")
+	cfgReq := sdk.NewConfigurationRequest(tfPrvdr.ConfigurationSchema())
+	cfgReq.SetAtPath("path", writeOutput(t, state))
+	if err := tfPrvdr.Configure(cfgReq); err != nil {
+		t.Fatalf("Failed to configure terraform provider, reason: %s", err)
+	}
+
+	data, diags := tfPrvdr.Provide(sdk.NewProviderDataRequest(tfPrvdr.ProvisionDataRequestSchema()))
+	if diags.HasErrors() {
+		t.Fatalf("Failed to read state, reason: %s", diags.Errors().String())
+	}
+
+	bucket, found := data.GetChildren("aws_s3_bucket.logs")
+	if !found {
+		t.Fatalf("Expected resource aws_s3_bucket.logs")
+	}
+
+	bucketValues, _ := bucket.First().GetChildren("values")
+	tags, _ := bucketValues.First().GetChildren("tags")
+	if team, found := tags.First().GetChildren("team"); !found || team.First().Value != "platform" {
+		t.Errorf("Expected resource values from state")
+	}
+
+	if _, found := bucket.First().GetChildren("after"); found {
+		t.Errorf("Expected no changes in state")
+	}
+}
+
+func TestTerraformProviderReturnsErrorWithUnknownOutput(t *testing.T) {
+	for _, content := range []string{`{"resources": []}`, `not json: [`} {
+		tfPrvdr := New("tf")
+		cfgReq := sdk.NewConfigurationRequest(tfPrvdr.ConfigurationSchema())
+		cfgReq.SetAtPath("path", writeOutput(t, content))
+		if err := tfPrvdr.Configure(cfgReq); err != nil {
+			t.Fatalf("Failed to configure terraform provider, reason: %s", err)
+		}
+
+		if _, diags := tfPrvdr.Provide(sdk.NewProviderDataRequest(tfPrvdr.ProvisionDataRequestSchema())); !diags.HasErrors() {
+			t.Errorf("Expected error with %s", content)
+		}
+	}
+}
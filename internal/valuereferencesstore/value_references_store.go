@@ -8,12 +8,17 @@
 package valuereferencesstore
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/conformize/conformize/common"
 	"github.com/conformize/conformize/common/ds"
 	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/util"
 )
 
 type ValueReferencesStore struct {
@@ -61,10 +66,33 @@ func (valRefStore *ValueReferencesStore) GetAtPath(refPath *path.Path) (*ds.Node
 	valueRef, found := valRefStore.valueReferences[root.String()]
 	valRefStore.rwLock.RUnlock()
 	if !found {
-		return nil, fmt.Errorf("couldn't find reference '%s'", root.String())
+		return nil, valRefStore.unresolvedReferenceError(root.String())
 	}
 
 	var walkErr error
 	valueRef, walkErr = valRefStore.exprPathEvaluator.Evaluate(valueRef, path.NewPath(steps))
+
+	var unresolvedErr *common.UnresolvedPathError
+	if errors.As(walkErr, &unresolvedErr) {
+		unresolvedErr.Resolved = append(path.Steps{root}, unresolvedErr.Resolved...)
+	}
 	return valueRef, walkErr
 }
+
+func (valRefStore *ValueReferencesStore) unresolvedReferenceError(refAlias string) error {
+	valRefStore.rwLock.RLock()
+	aliases := slices.Sorted(maps.Keys(valRefStore.valueReferences))
+	valRefStore.rwLock.RUnlock()
+
+	var similar []string
+	for _, alias := range aliases {
+		if strings.EqualFold(alias, refAlias) || util.EditDistance(alias, refAlias) <= max(1, len(refAlias)/4) {
+			similar = append(similar, "$"+alias)
+		}
+	}
+
+	if len(similar) > 0 {
+		return fmt.Errorf("couldn't find reference '%s', did you mean %s?", refAlias, strings.Join(similar, " or "))
+	}
+	return fmt.Errorf("couldn't find reference '%s'", refAlias)
+}
@@ -42,6 +42,36 @@ const (
 	DIFFERENT
 	WITHIN
 	FUTURE
+	NO_PLAINTEXT_SECRETS
+	CERT_VALID
+	CERT_EXPIRES_AFTER
+	CERT_HAS_SAN
+	CERT_ISSUED_BY
+	KEY_MATCHES_CERT
+	MIN_KEY_SIZE
+	CRON_VALID
+	CRON_RUNS_AT_MOST_EVERY
+	CRON_NEXT_RUN_WITHIN
+	LENGTH_BETWEEN
+	IS_SORTED
+	ALL_EQUAL
+	INTERSECTS
+	DISJOINT_FROM
+	COUNT_OF
+	UNIQUE_BY
+	CONTAINS_OBJECT_MATCHING
+	HAS_KEYS
+	KEYS_MATCH
+	NO_EXTRA_KEYS
+	EXISTS_IN
+	KEY_OF
+	IS_INTEGER
+	MULTIPLE_OF
+	MAX_DECIMAL_PLACES
+	IS_PERCENTAGE
+	IS_POWER_OF_TWO
+	APPROX_EQUAL
+	REGO
 	UNKNOWN
 )
 
@@ -53,6 +83,11 @@ var conditionTypeMap = func() map[string]ConditionType {
 	return m
 }()
 
+var conditionAliases = map[string]ConditionType{
+	"inFuture":   FUTURE,
+	"certHasSAN": CERT_HAS_SAN,
+}
+
 func toCamelCase(s string) string {
 	var result strings.Builder
 	nextUpper := false
@@ -77,9 +112,18 @@ func toCamelCase(s string) string {
 	return result.String()
 }
 
+// Name returns the name a condition is referred to by in a ruleset.
+func (c ConditionType) Name() string {
+	return toCamelCase(c.String())
+}
+
 func FromString(s string) ConditionType {
 	if c, ok := conditionTypeMap[s]; ok {
 		return c
 	}
+
+	if c, ok := conditionAliases[s]; ok {
+		return c
+	}
 	return UNKNOWN
 }
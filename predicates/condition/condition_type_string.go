@@ -34,12 +34,42 @@ func _() {
 	_ = x[DIFFERENT-23]
 	_ = x[WITHIN-24]
 	_ = x[FUTURE-25]
-	_ = x[UNKNOWN-26]
+	_ = x[NO_PLAINTEXT_SECRETS-26]
+	_ = x[CERT_VALID-27]
+	_ = x[CERT_EXPIRES_AFTER-28]
+	_ = x[CERT_HAS_SAN-29]
+	_ = x[CERT_ISSUED_BY-30]
+	_ = x[KEY_MATCHES_CERT-31]
+	_ = x[MIN_KEY_SIZE-32]
+	_ = x[CRON_VALID-33]
+	_ = x[CRON_RUNS_AT_MOST_EVERY-34]
+	_ = x[CRON_NEXT_RUN_WITHIN-35]
+	_ = x[LENGTH_BETWEEN-36]
+	_ = x[IS_SORTED-37]
+	_ = x[ALL_EQUAL-38]
+	_ = x[INTERSECTS-39]
+	_ = x[DISJOINT_FROM-40]
+	_ = x[COUNT_OF-41]
+	_ = x[UNIQUE_BY-42]
+	_ = x[CONTAINS_OBJECT_MATCHING-43]
+	_ = x[HAS_KEYS-44]
+	_ = x[KEYS_MATCH-45]
+	_ = x[NO_EXTRA_KEYS-46]
+	_ = x[EXISTS_IN-47]
+	_ = x[KEY_OF-48]
+	_ = x[IS_INTEGER-49]
+	_ = x[MULTIPLE_OF-50]
+	_ = x[MAX_DECIMAL_PLACES-51]
+	_ = x[IS_PERCENTAGE-52]
+	_ = x[IS_POWER_OF_TWO-53]
+	_ = x[APPROX_EQUAL-54]
+	_ = x[REGO-55]
+	_ = x[UNKNOWN-56]
 }
 
-const _ConditionType_name = "EQNOTGTLTGTELTEHASLACKSTRUEFALSEMATCHESRANGEEMPTYNOT_EMPTYSUBSET_OFUNIQUEHAS_ANYBEFOREAFTERUNTILSINCEVALIDSAMEDIFFERENTWITHINFUTUREUNKNOWN"
+const _ConditionType_name = "EQNOTGTLTGTELTEHASLACKSTRUEFALSEMATCHESRANGEEMPTYNOT_EMPTYSUBSET_OFUNIQUEHAS_ANYBEFOREAFTERUNTILSINCEVALIDSAMEDIFFERENTWITHINFUTURENO_PLAINTEXT_SECRETSCERT_VALIDCERT_EXPIRES_AFTERCERT_HAS_SANCERT_ISSUED_BYKEY_MATCHES_CERTMIN_KEY_SIZECRON_VALIDCRON_RUNS_AT_MOST_EVERYCRON_NEXT_RUN_WITHINLENGTH_BETWEENIS_SORTEDALL_EQUALINTERSECTSDISJOINT_FROMCOUNT_OFUNIQUE_BYCONTAINS_OBJECT_MATCHINGHAS_KEYSKEYS_MATCHNO_EXTRA_KEYSEXISTS_INKEY_OFIS_INTEGERMULTIPLE_OFMAX_DECIMAL_PLACESIS_PERCENTAGEIS_POWER_OF_TWOAPPROX_EQUALREGOUNKNOWN"
 
-var _ConditionType_index = [...]uint8{0, 2, 5, 7, 9, 12, 15, 18, 23, 27, 32, 39, 44, 49, 58, 67, 73, 80, 86, 91, 96, 101, 106, 110, 119, 125, 131, 138}
+var _ConditionType_index = [...]uint16{0, 2, 5, 7, 9, 12, 15, 18, 23, 27, 32, 39, 44, 49, 58, 67, 73, 80, 86, 91, 96, 101, 106, 110, 119, 125, 131, 151, 161, 179, 191, 205, 221, 233, 243, 266, 286, 300, 309, 318, 328, 341, 349, 358, 382, 390, 400, 413, 422, 428, 438, 449, 467, 480, 495, 507, 511, 518}
 
 func (i ConditionType) String() string {
 	if i < 0 || i >= ConditionType(len(_ConditionType_index)-1) {
@@ -0,0 +1,24 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package predicates
+
+type Finding struct {
+	Path    string
+	Message string
+}
+
+func (f *Finding) String() string {
+	if len(f.Path) == 0 {
+		return f.Message
+	}
+	return f.Path + ": " + f.Message
+}
+
+type FindingsReporter interface {
+	Findings() []Finding
+}
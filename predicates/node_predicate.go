@@ -0,0 +1,18 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package predicates
+
+import (
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/typed"
+)
+
+type NodePredicate interface {
+	Predicate
+	TestNode(node *ds.Node[string, any], args typed.Valuable) (bool, error)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package certificate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type CertExpiresAfterPredicate struct{}
+
+func (certExpiresAfterPrd *CertExpiresAfterPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	cert, err := parseCertificate(value)
+	if err != nil {
+		return false, err
+	}
+
+	if args == nil {
+		return false, fmt.Errorf("argument is nil")
+	}
+
+	if args.Type().Hint().TypeHint() != typed.String {
+		return false, fmt.Errorf("expected a duration argument, got %s", args.Type().Name())
+	}
+
+	var durationVal string
+	args.As(&durationVal)
+
+	duration, err := util.ParseDuration(durationVal)
+	if err != nil {
+		return false, err
+	}
+	return cert.NotAfter.After(time.Now().Add(duration)), nil
+}
+
+func (certExpiresAfterPrd *CertExpiresAfterPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Certificate expires after a given duration from now predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded certificate or path to a certificate file",
+			},
+			"Arguments": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Duration such as 30d, 2w or 72h",
+			},
+		},
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package certificate
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type CertHasSANPredicate struct{}
+
+func (certHasSANPrd *CertHasSANPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	cert, err := parseCertificate(value)
+	if err != nil {
+		return false, err
+	}
+
+	if args == nil {
+		return false, fmt.Errorf("arguments are nil")
+	}
+
+	var names []string
+	switch arg := args.(type) {
+	case *typed.StringValue:
+		var name string
+		arg.As(&name)
+		names = append(names, name)
+	case typed.Elementable:
+		for _, elem := range arg.Items() {
+			if elem.Type().Hint().TypeHint() != typed.String {
+				return false, fmt.Errorf("expected subject alternative names to be strings, got %s", elem.Type().Name())
+			}
+
+			var name string
+			elem.As(&name)
+			names = append(names, name)
+		}
+	default:
+		return false, fmt.Errorf("expected a string or a list of strings as arguments, got %s", args.Type().Name())
+	}
+
+	sans := make(map[string]struct{})
+	for _, dnsName := range cert.DNSNames {
+		sans[strings.ToLower(dnsName)] = struct{}{}
+	}
+	for _, email := range cert.EmailAddresses {
+		sans[strings.ToLower(email)] = struct{}{}
+	}
+	for _, ip := range cert.IPAddresses {
+		sans[ip.String()] = struct{}{}
+	}
+	for _, uri := range cert.URIs {
+		sans[uri.String()] = struct{}{}
+	}
+
+	for _, name := range names {
+		key := strings.ToLower(name)
+		if ip := net.ParseIP(name); ip != nil {
+			key = ip.String()
+		}
+
+		if _, found := sans[key]; !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (certHasSANPrd *CertHasSANPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Certificate has subject alternative names predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded certificate or path to a certificate file",
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Required: true,
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.ListTyped{ElementsType: &typed.StringTyped{}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package certificate
+
+import (
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type CertIsValidPredicate struct{}
+
+func (certIsValidPrd *CertIsValidPredicate) Test(value typed.Valuable, _ typed.Valuable) (bool, error) {
+	cert, err := parseCertificate(value)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	return !now.Before(cert.NotBefore) && !now.After(cert.NotAfter), nil
+}
+
+func (certIsValidPrd *CertIsValidPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Certificate is currently valid predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded certificate or path to a certificate file",
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package certificate
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type CertIssuedByPredicate struct{}
+
+func (certIssuedByPrd *CertIssuedByPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	cert, err := parseCertificate(value)
+	if err != nil {
+		return false, err
+	}
+
+	if args == nil {
+		return false, fmt.Errorf("argument is nil")
+	}
+
+	if args.Type().Hint().TypeHint() != typed.String {
+		return false, fmt.Errorf("expected an issuer name or CA certificate argument, got %s", args.Type().Name())
+	}
+
+	var issuer string
+	args.As(&issuer)
+	if issuer == cert.Issuer.CommonName || issuer == cert.Issuer.String() {
+		return true, nil
+	}
+
+	caCerts, err := parseCertificates(args)
+	if err != nil {
+		if isInlinePEM(issuer) || isPEMFilePath(issuer) {
+			return false, err
+		}
+		return false, nil
+	}
+
+	for _, caCert := range caCerts {
+		if cert.CheckSignatureFrom(caCert) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (certIssuedByPrd *CertIssuedByPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Certificate is issued by predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded certificate or path to a certificate file",
+			},
+			"Arguments": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Issuer common name, distinguished name, or CA certificate (PEM or file path)",
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package certificate
+
+import (
+	"crypto"
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type publicKeyComparable interface {
+	Equal(x crypto.PublicKey) bool
+}
+
+type KeyMatchesCertPredicate struct{}
+
+func (keyMatchesCertPrd *KeyMatchesCertPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	privateKey, err := parsePrivateKey(value)
+	if err != nil {
+		return false, err
+	}
+
+	if args == nil {
+		return false, fmt.Errorf("argument is nil")
+	}
+
+	cert, err := parseCertificate(args)
+	if err != nil {
+		return false, err
+	}
+
+	publicKey, ok := privateKey.Public().(publicKeyComparable)
+	if !ok {
+		return false, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+	return publicKey.Equal(cert.PublicKey), nil
+}
+
+func (keyMatchesCertPrd *KeyMatchesCertPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Private key matches certificate predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded private key or path to a private key file",
+			},
+			"Arguments": &attributes.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded certificate or path to a certificate file",
+			},
+		},
+	}
+}
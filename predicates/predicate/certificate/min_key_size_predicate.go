@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package certificate
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type MinKeySizePredicate struct{}
+
+func (minKeySizePrd *MinKeySizePredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	publicKey, err := parsePublicKey(value)
+	if err != nil {
+		return false, err
+	}
+
+	if args == nil {
+		return false, fmt.Errorf("argument is nil")
+	}
+
+	if args.Type().Hint().TypeHint() != typed.Number {
+		return false, fmt.Errorf("expected a number argument, got %s", args.Type().Name())
+	}
+
+	var minBits float64
+	args.As(&minBits)
+
+	bits, err := keySize(publicKey)
+	if err != nil {
+		return false, err
+	}
+	return float64(bits) >= minBits, nil
+}
+
+func (minKeySizePrd *MinKeySizePredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Key size is at least a number of bits predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded certificate or private key, or path to a PEM file",
+			},
+			"Arguments": &attributes.NumberAttribute{
+				Required:    true,
+				Description: "Minimum key size in bits",
+			},
+		},
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package certificate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+)
+
+const pemBoundary = "-----BEGIN"
+
+func isInlinePEM(s string) bool {
+	return strings.Contains(s, pemBoundary)
+}
+
+// isPEMFilePath tells whether a value names a PEM file rather than e.g. an issuer name:
+// it contains a path separator, has a certificate file extension or names an existing file.
+func isPEMFilePath(s string) bool {
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, `/\`) {
+		return true
+	}
+
+	switch strings.ToLower(filepath.Ext(s)) {
+	case ".pem", ".crt", ".cer":
+		return true
+	}
+
+	filePath, err := util.ResolveFilePath(s)
+	if err != nil {
+		return false
+	}
+	fileInfo, err := os.Stat(filePath)
+	return err == nil && !fileInfo.IsDir()
+}
+
+// readPEM returns the PEM content of an inline value, or reads it from a file
+// path resolved relative to the blueprint working directory.
+func readPEM(value typed.Valuable) ([]byte, error) {
+	if value == nil {
+		return nil, fmt.Errorf("value is nil")
+	}
+
+	if value.Type().Hint().TypeHint() != typed.String {
+		return nil, fmt.Errorf("expected a PEM encoded string or file path, got %s", value.Type().Name())
+	}
+
+	var s string
+	value.As(&s)
+	if isInlinePEM(s) {
+		return []byte(s), nil
+	}
+
+	filePath, err := util.ResolveFilePath(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't resolve PEM file: %w", err)
+	}
+	return os.ReadFile(filePath)
+}
+
+func parseCertificates(value typed.Valuable) ([]*x509.Certificate, error) {
+	pemData, err := readPEM(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(pemData); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+func parseCertificate(value typed.Valuable) (*x509.Certificate, error) {
+	certs, err := parseCertificates(value)
+	if err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
+
+func parsePrivateKey(value typed.Valuable) (crypto.Signer, error) {
+	pemData, err := readPEM(value)
+	if err != nil {
+		return nil, err
+	}
+
+	for block, rest := pem.Decode(pemData); block != nil; block, rest = pem.Decode(rest) {
+		if !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			continue
+		}
+
+		var key any
+		switch block.Type {
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		default:
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse private key: %w", err)
+		}
+
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	return nil, fmt.Errorf("no PEM encoded private key found")
+}
+
+// parsePublicKey accepts either a certificate or a private key and returns its public key.
+func parsePublicKey(value typed.Valuable) (crypto.PublicKey, error) {
+	if cert, err := parseCertificate(value); err == nil {
+		return cert.PublicKey, nil
+	}
+
+	signer, err := parsePrivateKey(value)
+	if err != nil {
+		return nil, fmt.Errorf("no PEM encoded certificate or private key found")
+	}
+	return signer.Public(), nil
+}
+
+func keySize(publicKey crypto.PublicKey) (int, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return key.N.BitLen(), nil
+	case *ecdsa.PublicKey:
+		return key.Curve.Params().BitSize, nil
+	case ed25519.PublicKey:
+		return ed25519.PublicKeySize * 8, nil
+	default:
+		return 0, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
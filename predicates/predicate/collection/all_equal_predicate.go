@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/spaolacci/murmur3"
+)
+
+type AllEqualPredicate struct{}
+
+func (allEqualPrd *AllEqualPredicate) Test(value typed.Valuable, _ typed.Valuable) (bool, error) {
+	elements, ok := collectionItems(value)
+	if !ok {
+		return false, fmt.Errorf("expected a list, tuple or map value, got %T", value)
+	}
+
+	if len(elements) < 2 {
+		return true, nil
+	}
+
+	seed := uint32(time.Now().UnixNano())
+	hasher := murmur3.New128WithSeed(seed)
+	firstKey, err := getElementKey(elements[0], hasher, seed)
+	if err != nil {
+		return false, err
+	}
+
+	for _, elem := range elements[1:] {
+		elemKey, err := getElementKey(elem, hasher, seed)
+		if err != nil {
+			return false, err
+		}
+
+		if !bytes.Equal(firstKey, elemKey) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (allEqualPrd *AllEqualPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "All elements in a collection are equal predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+		},
+	}
+}
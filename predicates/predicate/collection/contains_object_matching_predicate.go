@@ -0,0 +1,101 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/spaolacci/murmur3"
+)
+
+type ContainsObjectMatchingPredicate struct{}
+
+func (containsObjMatchingPrd *ContainsObjectMatchingPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	elements, ok := collectionItems(value)
+	if !ok {
+		return false, fmt.Errorf("expected a list of objects, got %T", value)
+	}
+
+	if _, ok := functions.FieldsOf(args); !ok {
+		return false, fmt.Errorf("expected a partial object as argument, got %T", args)
+	}
+
+	seed := uint32(time.Now().UnixNano())
+	hasher := murmur3.New128WithSeed(seed)
+	for _, elem := range elements {
+		match, err := matchesPartially(elem, args, hasher, seed)
+		if err != nil {
+			return false, err
+		}
+
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchesPartially reports whether value has every field of pattern, comparing
+// nested objects partially and everything else by equality.
+func matchesPartially(value typed.Valuable, pattern typed.Valuable, hasher murmur3.Hash128, seed uint32) (bool, error) {
+	patternFields, isObject := functions.FieldsOf(pattern)
+	if !isObject {
+		valueKey, err := getElementKey(value, hasher, seed)
+		if err != nil {
+			return false, err
+		}
+
+		patternKey, err := getElementKey(pattern, hasher, seed)
+		if err != nil {
+			return false, err
+		}
+		return bytes.Equal(valueKey, patternKey), nil
+	}
+
+	fields, ok := functions.FieldsOf(value)
+	if !ok {
+		return false, nil
+	}
+
+	for key, patternField := range patternFields {
+		field, ok := fields[key]
+		if !ok {
+			return false, nil
+		}
+
+		match, err := matchesPartially(field, patternField, hasher, seed)
+		if err != nil || !match {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (containsObjMatchingPrd *ContainsObjectMatchingPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "List contains an object matching a partial object predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+			},
+			"Arguments": &attributes.MapAttribute{
+				Required:     true,
+				Description:  "Partial object whose fields must all be present and equal",
+				ElementsType: &typed.GenericTyped{},
+			},
+		},
+	}
+}
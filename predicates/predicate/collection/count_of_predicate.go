@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/spaolacci/murmur3"
+)
+
+type CountOfPredicate struct{}
+
+// Test checks that a collection contains an element exactly the given number of times.
+func (countOfPrd *CountOfPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	elements, ok := collectionItems(value)
+	if !ok {
+		return false, fmt.Errorf("expected a list, tuple or map value, got %T", value)
+	}
+
+	argsElemVal, ok := args.(typed.Elementable)
+	if !ok || argsElemVal.Length() != 2 {
+		return false, fmt.Errorf("expected an element and its expected count as argument")
+	}
+
+	expectedCount, ok := numberOf(argsElemVal.Items()[1])
+	if !ok {
+		return false, fmt.Errorf("expected a numeric count, got %s", argsElemVal.Items()[1].Type().Name())
+	}
+
+	seed := uint32(time.Now().UnixNano())
+	hasher := murmur3.New128WithSeed(seed)
+	counts, err := elementKeys(elements, hasher, seed)
+	if err != nil {
+		return false, err
+	}
+
+	elemKey, err := getElementKey(argsElemVal.Items()[0], hasher, seed)
+	if err != nil {
+		return false, err
+	}
+	return float64(counts[string(elemKey)]) == expectedCount, nil
+}
+
+func (countOfPrd *CountOfPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Element occurs in a collection an exact number of times predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.TupleAttribute{
+				Required:    true,
+				Description: "Element and its expected number of occurrences",
+				ElementsTypes: []typed.Typeable{
+					&typed.GenericTyped{},
+					&typed.NumberTyped{},
+				},
+			},
+		},
+	}
+}
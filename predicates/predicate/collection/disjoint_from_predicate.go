@@ -0,0 +1,39 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type DisjointFromPredicate struct{}
+
+func (disjointFromPrd *DisjointFromPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	intersectsPrd := IntersectsPredicate{}
+	intersects, err := intersectsPrd.Test(value, args)
+	return !intersects && err == nil, err
+}
+
+func (disjointFromPrd *DisjointFromPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Collections have no elements in common predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+		},
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
 	"github.com/spaolacci/murmur3"
 )
 
@@ -124,3 +126,107 @@ func elementHash(val typed.Valuable, hasher murmur3.Hash128, seed uint32) ([16]b
 	hasher.Sum(hash[:0])
 	return hash, nil
 }
+
+func elementKeys(elements []typed.Valuable, hasher murmur3.Hash128, seed uint32) (map[string]int, error) {
+	keys := make(map[string]int, len(elements))
+	for _, elem := range elements {
+		elemKey, err := getElementKey(elem, hasher, seed)
+		if err != nil {
+			return nil, err
+		}
+		keys[string(elemKey)]++
+	}
+	return keys, nil
+}
+
+// collectionItems returns the elements of a list or tuple, or the values of a map ordered by key.
+func collectionItems(value typed.Valuable) ([]typed.Valuable, bool) {
+	switch v := value.(type) {
+	case typed.Elementable:
+		return v.Items(), true
+	case *typed.MapValue:
+		keys := functions.SortedKeys(v.Elements)
+		items := make([]typed.Valuable, len(keys))
+		for idx, k := range keys {
+			items[idx] = v.Elements[k]
+		}
+		return items, true
+	default:
+		return nil, false
+	}
+}
+
+// hasCommonElements reports whether any of elements is also in other.
+func hasCommonElements(elements []typed.Valuable, other []typed.Valuable) (bool, error) {
+	seed := uint32(time.Now().UnixNano())
+	hasher := murmur3.New128WithSeed(seed)
+
+	seen, err := elementKeys(elements, hasher, seed)
+	if err != nil {
+		return false, err
+	}
+
+	for _, elem := range other {
+		elemKey, err := getElementKey(elem, hasher, seed)
+		if err != nil {
+			return false, err
+		}
+
+		if _, found := seen[string(elemKey)]; found {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func fieldOf(value typed.Valuable, name string) (typed.Valuable, bool) {
+	fields, ok := functions.FieldsOf(value)
+	if !ok {
+		return nil, false
+	}
+
+	field, ok := fields[name]
+	return field, ok
+}
+
+func numberOf(value typed.Valuable) (float64, bool) {
+	if value == nil || value.Type().Hint().TypeHint() != typed.Number {
+		return 0, false
+	}
+
+	var num float64
+	if err := value.As(&num); err != nil {
+		return 0, false
+	}
+	return num, true
+}
+
+// stringsOf accepts a single string or a list of strings.
+func stringsOf(value typed.Valuable) ([]string, error) {
+	if value == nil {
+		return nil, fmt.Errorf("argument is nil")
+	}
+
+	if value.Type().Hint().TypeHint() == typed.String {
+		var s string
+		value.As(&s)
+		return []string{s}, nil
+	}
+
+	elemVal, ok := value.(typed.Elementable)
+	if !ok {
+		return nil, fmt.Errorf("expected a string or a list of strings, got %s", value.Type().Name())
+	}
+
+	values := make([]string, 0, elemVal.Length())
+	for _, elem := range elemVal.Items() {
+		if elem.Type().Hint().TypeHint() != typed.String {
+			return nil, fmt.Errorf("expected a list of strings, got element of type %s", elem.Type().Name())
+		}
+
+		var s string
+		elem.As(&s)
+		values = append(values, s)
+	}
+	return values, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type HasKeysPredicate struct {
+	findings []predicates.Finding
+}
+
+func (hasKeysPrd *HasKeysPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	fields, ok := functions.FieldsOf(value)
+	if !ok {
+		return false, fmt.Errorf("expected an object or map value, got %T", value)
+	}
+
+	keys, err := stringsOf(args)
+	if err != nil {
+		return false, err
+	}
+
+	findingsCount := len(hasKeysPrd.findings)
+	for _, key := range keys {
+		if _, found := fields[key]; found {
+			continue
+		}
+
+		steps := path.Steps{}
+		steps.Add(path.KeyStep(key))
+		hasKeysPrd.findings = append(hasKeysPrd.findings, predicates.Finding{
+			Path:    steps.String(),
+			Message: "required key is missing",
+		})
+	}
+	return len(hasKeysPrd.findings) == findingsCount, nil
+}
+
+func (hasKeysPrd *HasKeysPredicate) Findings() []predicates.Finding {
+	return hasKeysPrd.findings
+}
+
+func (hasKeysPrd *HasKeysPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Object has all of the keys predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.MapAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Key or list of required keys",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.ListTyped{ElementsType: &typed.StringTyped{}},
+				},
+			},
+		},
+	}
+}
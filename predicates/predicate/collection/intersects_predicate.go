@@ -0,0 +1,48 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type IntersectsPredicate struct{}
+
+func (intersectsPrd *IntersectsPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	elements, ok := collectionItems(value)
+	if !ok {
+		return false, fmt.Errorf("expected a list, tuple or map value, got %T", value)
+	}
+
+	otherElements, ok := collectionItems(args)
+	if !ok {
+		return false, fmt.Errorf("expected a list, tuple or map value as argument, got %T", args)
+	}
+	return hasCommonElements(elements, otherElements)
+}
+
+func (intersectsPrd *IntersectsPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Collections have at least one element in common predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+const (
+	sortAscending  = "asc"
+	sortDescending = "desc"
+)
+
+type IsSortedPredicate struct{}
+
+func (isSortedPrd *IsSortedPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	elemVal, ok := value.(typed.Elementable)
+	if !ok {
+		return false, fmt.Errorf("expected a list or tuple value, got %T", value)
+	}
+
+	order, sortKey, err := sortOptions(args)
+	if err != nil {
+		return false, err
+	}
+
+	elements := elemVal.Items()
+	for idx := 1; idx < len(elements); idx++ {
+		prev, err := sortValue(elements[idx-1], sortKey)
+		if err != nil {
+			return false, err
+		}
+
+		curr, err := sortValue(elements[idx], sortKey)
+		if err != nil {
+			return false, err
+		}
+
+		cmp, err := compareSortValues(prev, curr)
+		if err != nil {
+			return false, err
+		}
+
+		if (order == sortAscending && cmp > 0) || (order == sortDescending && cmp < 0) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// sortOptions accepts no argument, an order ("asc" or "desc"), or an object with
+// optional "order" and "by" fields for sorting objects by a key.
+func sortOptions(args typed.Valuable) (string, string, error) {
+	if args == nil {
+		return sortAscending, "", nil
+	}
+
+	var order, sortKey string
+	switch args.Type().Hint().TypeHint() {
+	case typed.String:
+		args.As(&order)
+	case typed.Map, typed.Object:
+		if orderVal, ok := fieldOf(args, "order"); ok {
+			if orderVal.Type().Hint().TypeHint() != typed.String {
+				return "", "", fmt.Errorf("expected a string sort order, got %s", orderVal.Type().Name())
+			}
+			orderVal.As(&order)
+		}
+
+		if byVal, ok := fieldOf(args, "by"); ok {
+			if byVal.Type().Hint().TypeHint() != typed.String {
+				return "", "", fmt.Errorf("expected a string sort key, got %s", byVal.Type().Name())
+			}
+			byVal.As(&sortKey)
+		}
+	default:
+		return "", "", fmt.Errorf("expected a sort order or sort options as argument, got %s", args.Type().Name())
+	}
+
+	order = strings.ToLower(strings.TrimSpace(order))
+	switch order {
+	case "":
+		return sortAscending, sortKey, nil
+	case sortAscending, sortDescending:
+		return order, sortKey, nil
+	default:
+		return "", "", fmt.Errorf("unknown sort order '%s', expected %s or %s", order, sortAscending, sortDescending)
+	}
+}
+
+func sortValue(elem typed.Valuable, sortKey string) (typed.Valuable, error) {
+	if len(sortKey) == 0 {
+		return elem, nil
+	}
+
+	field, ok := fieldOf(elem, sortKey)
+	if !ok {
+		return nil, fmt.Errorf("element has no key '%s'", sortKey)
+	}
+	return field, nil
+}
+
+func compareSortValues(a, b typed.Valuable) (int, error) {
+	aHint, bHint := a.Type().Hint().TypeHint(), b.Type().Hint().TypeHint()
+	if aHint != bHint {
+		return 0, fmt.Errorf("cannot compare %s with %s", a.Type().Name(), b.Type().Name())
+	}
+
+	switch aHint {
+	case typed.Number:
+		aNum, _ := numberOf(a)
+		bNum, _ := numberOf(b)
+		switch {
+		case aNum < bNum:
+			return -1, nil
+		case aNum > bNum:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case typed.String:
+		var aStr, bStr string
+		a.As(&aStr)
+		b.As(&bStr)
+		return strings.Compare(aStr, bStr), nil
+	default:
+		return 0, fmt.Errorf("cannot order values of type %s", a.Type().Name())
+	}
+}
+
+func (isSortedPrd *IsSortedPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Collection is sorted predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Description: "Sort order (asc or desc), or an object with order and by fields",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.MapTyped{ElementsType: &typed.StringTyped{}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type KeysMatchPredicate struct {
+	findings []predicates.Finding
+}
+
+func (keysMatchPrd *KeysMatchPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	fields, ok := functions.FieldsOf(value)
+	if !ok {
+		return false, fmt.Errorf("expected an object or map value, got %T", value)
+	}
+
+	if args == nil || args.Type().Hint().TypeHint() != typed.String {
+		return false, fmt.Errorf("expected a regular expression as argument")
+	}
+
+	var expr string
+	args.As(&expr)
+
+	keyExp, err := regexp.Compile(expr)
+	if err != nil {
+		return false, fmt.Errorf("invalid regular expression '%s': %w", expr, err)
+	}
+
+	findingsCount := len(keysMatchPrd.findings)
+	for _, key := range functions.SortedKeys(fields) {
+		if keyExp.MatchString(key) {
+			continue
+		}
+
+		steps := path.Steps{}
+		steps.Add(path.KeyStep(key))
+		keysMatchPrd.findings = append(keysMatchPrd.findings, predicates.Finding{
+			Path:    steps.String(),
+			Message: fmt.Sprintf("key doesn't match %s", expr),
+		})
+	}
+	return len(keysMatchPrd.findings) == findingsCount, nil
+}
+
+func (keysMatchPrd *KeysMatchPredicate) Findings() []predicates.Finding {
+	return keysMatchPrd.findings
+}
+
+func (keysMatchPrd *KeysMatchPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "All object keys match a regular expression predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.MapAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Regular expression keys must match",
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type LacksElementsPredicate struct{}
+
+func (lacksElementsPrd *LacksElementsPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	if value == nil || args == nil {
+		return false, fmt.Errorf("arguments or value cannot be nil")
+	}
+
+	elements, ok := collectionItems(value)
+	if !ok {
+		return false, fmt.Errorf("expected a list, tuple or map value, got %s", value.Type().Name())
+	}
+
+	unwanted := []typed.Valuable{args}
+	if argsElements, ok := collectionItems(args); ok {
+		unwanted = argsElements
+	}
+
+	found, err := hasCommonElements(elements, unwanted)
+	return !found && err == nil, err
+}
+
+func (lacksElementsPrd *LacksElementsPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "None of the elements are in a collection predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Required: true,
+				VariantsTypes: []typed.Typeable{
+					&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.GenericTyped{},
+				},
+			},
+		},
+	}
+}
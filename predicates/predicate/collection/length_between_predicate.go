@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type LengthBetweenPredicate struct{}
+
+func (lengthBetweenPrd *LengthBetweenPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	if value == nil || args == nil {
+		return false, fmt.Errorf("arguments or value cannot be nil")
+	}
+
+	lengthVal, ok := value.(typed.Lengthable)
+	if !ok {
+		return false, fmt.Errorf("expected a list, tuple, map or string value, got %s", value.Type().Name())
+	}
+
+	bounds, ok := args.(typed.Elementable)
+	if !ok || bounds.Length() != 2 {
+		return false, fmt.Errorf("expected a lower and upper length bound as argument")
+	}
+
+	lower, lowerOk := numberOf(bounds.Items()[0])
+	upper, upperOk := numberOf(bounds.Items()[1])
+	if !lowerOk || !upperOk {
+		return false, fmt.Errorf("expected numeric length bounds")
+	}
+
+	if lower > upper {
+		return false, fmt.Errorf("lower length bound %v is greater than upper bound %v", lower, upper)
+	}
+
+	length := float64(lengthVal.Length())
+	return length >= lower && length <= upper, nil
+}
+
+func (lengthBetweenPrd *LengthBetweenPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Collection length is within an inclusive range predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.TupleAttribute{
+				Required: true,
+				ElementsTypes: []typed.Typeable{
+					&typed.NumberTyped{},
+					&typed.NumberTyped{},
+				},
+				Description: "Lower and upper length bounds",
+			},
+		},
+	}
+}
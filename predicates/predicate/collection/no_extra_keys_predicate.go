@@ -0,0 +1,80 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type NoExtraKeysPredicate struct {
+	findings []predicates.Finding
+}
+
+func (noExtraKeysPrd *NoExtraKeysPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	fields, ok := functions.FieldsOf(value)
+	if !ok {
+		return false, fmt.Errorf("expected an object or map value, got %T", value)
+	}
+
+	allowedKeys, err := stringsOf(args)
+	if err != nil {
+		return false, err
+	}
+
+	allowed := make(map[string]struct{}, len(allowedKeys))
+	for _, key := range allowedKeys {
+		allowed[key] = struct{}{}
+	}
+
+	findingsCount := len(noExtraKeysPrd.findings)
+	for _, key := range functions.SortedKeys(fields) {
+		if _, found := allowed[key]; found {
+			continue
+		}
+
+		steps := path.Steps{}
+		steps.Add(path.KeyStep(key))
+		noExtraKeysPrd.findings = append(noExtraKeysPrd.findings, predicates.Finding{
+			Path:    steps.String(),
+			Message: "key is not allowed",
+		})
+	}
+	return len(noExtraKeysPrd.findings) == findingsCount, nil
+}
+
+func (noExtraKeysPrd *NoExtraKeysPredicate) Findings() []predicates.Finding {
+	return noExtraKeysPrd.findings
+}
+
+func (noExtraKeysPrd *NoExtraKeysPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Object has no keys other than the allowed ones predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.MapAttribute{
+				Required:     true,
+				ElementsType: &typed.GenericTyped{},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Allowed key or list of allowed keys",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.ListTyped{ElementsType: &typed.StringTyped{}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package collection
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+	"github.com/spaolacci/murmur3"
+)
+
+type UniqueByPredicate struct {
+	findings []predicates.Finding
+}
+
+func (uniqueByPrd *UniqueByPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	elements, ok := collectionItems(value)
+	if !ok {
+		return false, fmt.Errorf("expected a list of objects, got %T", value)
+	}
+
+	keys, err := stringsOf(args)
+	if err != nil {
+		return false, err
+	}
+
+	if len(keys) == 0 {
+		return false, fmt.Errorf("expected at least one key to compare objects by")
+	}
+
+	seed := uint32(time.Now().UnixNano())
+	hasher := murmur3.New128WithSeed(seed)
+	seen := make(map[string]int, len(elements))
+	findingsCount := len(uniqueByPrd.findings)
+	for idx, elem := range elements {
+		fields, ok := functions.FieldsOf(elem)
+		if !ok {
+			return false, fmt.Errorf("expected element %d to be an object, got %s", idx, elem.Type().Name())
+		}
+
+		var compositeKey []byte
+		for _, key := range keys {
+			field, ok := fields[key]
+			if !ok {
+				return false, fmt.Errorf("element %d has no key '%s'", idx, key)
+			}
+
+			fieldKey, err := getElementKey(field, hasher, seed)
+			if err != nil {
+				return false, err
+			}
+			compositeKey = binary.AppendUvarint(compositeKey, uint64(len(fieldKey)))
+			compositeKey = append(compositeKey, fieldKey...)
+		}
+
+		firstIdx, found := seen[string(compositeKey)]
+		if !found {
+			seen[string(compositeKey)] = idx
+			continue
+		}
+
+		steps := path.Steps{}
+		steps.Add(path.IndexStep(strconv.Itoa(idx)))
+		uniqueByPrd.findings = append(uniqueByPrd.findings, predicates.Finding{
+			Path:    steps.String(),
+			Message: fmt.Sprintf("duplicates element %d by %s", firstIdx, strings.Join(keys, ", ")),
+		})
+	}
+	return len(uniqueByPrd.findings) == findingsCount, nil
+}
+
+func (uniqueByPrd *UniqueByPredicate) Findings() []predicates.Finding {
+	return uniqueByPrd.findings
+}
+
+func (uniqueByPrd *UniqueByPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Objects in a list are unique by a set of keys predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.ListAttribute{
+				Required:     true,
+				ElementsType: &typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Key or list of keys to compare objects by",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.ListTyped{ElementsType: &typed.StringTyped{}},
+				},
+			},
+		},
+	}
+}
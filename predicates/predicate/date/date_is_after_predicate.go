@@ -8,8 +8,6 @@
 package date
 
 import (
-	"time"
-
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/internal/providers/api/schema"
 	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
@@ -18,35 +16,34 @@ import (
 type DateIsAfterPredicate struct{}
 
 func (dateIsAfterPrd *DateIsAfterPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
-	var dateVal string
-	value.As(&dateVal)
-
-	date, err := time.Parse(dateVal, dateVal)
-	if err != nil {
-		return false, err
-	}
-
-	var oDateVal string
-	oDateArg := args
-	oDateArg.As(&oDateVal)
-
-	oDate, err := time.Parse(oDateVal, oDateVal)
+	dates, err := parseDates(value, args, "date")
 	if err != nil {
 		return false, err
 	}
-	return date.After(oDate), nil
+	return dates[0].After(dates[1]), nil
 }
 
-func (dateIsBeforePrd *DateIsAfterPredicate) Schema() schema.Schemable {
+func (dateIsAfterPrd *DateIsAfterPredicate) Schema() schema.Schemable {
 	return &schema.Schema{
 		Description: "Date is after predicate",
 		Version:     1,
 		Attributes: map[string]schema.Attributeable{
-			"Value": &attributes.StringAttribute{
-				Required: true,
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date string or Unix epoch number",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+				},
 			},
-			"Arguments": &attributes.StringAttribute{
-				Required: true,
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date, relative date such as now+30d, or an object with date, layout and timezone",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
 			},
 		},
 	}
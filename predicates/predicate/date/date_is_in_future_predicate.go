@@ -8,7 +8,7 @@
 package date
 
 import (
-	"time"
+	"fmt"
 
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/internal/providers/api/schema"
@@ -17,15 +17,29 @@ import (
 
 type DateIsInFuture struct{}
 
+// Test checks that the date is after an optional threshold date, which defaults to now.
 func (dateIsInFuturePrd *DateIsInFuture) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
-	var dateVal string
-	value.As(&dateVal)
+	argDates, opts, err := dateArguments(args, optionalDateFields(args, "date"))
+	if err != nil {
+		return false, err
+	}
+
+	if len(argDates) > 1 {
+		return false, fmt.Errorf("expected at most 1 date argument, got %d", len(argDates))
+	}
 
-	date, err := time.Parse(dateVal, dateVal)
+	date, err := parseDate(value, opts, true)
 	if err != nil {
 		return false, err
 	}
-	return date.After(time.Now()), nil
+
+	threshold := opts.now
+	if len(argDates) == 1 {
+		if threshold, err = parseDate(argDates[0], opts, false); err != nil {
+			return false, err
+		}
+	}
+	return date.After(threshold), nil
 }
 
 func (dateIsInFuturePrd *DateIsInFuture) Schema() schema.Schemable {
@@ -33,8 +47,21 @@ func (dateIsInFuturePrd *DateIsInFuture) Schema() schema.Schemable {
 		Description: "Date is in future predicate",
 		Version:     1,
 		Attributes: map[string]schema.Attributeable{
-			"Value": &attributes.StringAttribute{
-				Required: true,
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date string or Unix epoch number",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+				},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Description: "Optional threshold date, relative date such as now+30d, or an object with date, layout and timezone",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
 			},
 		},
 	}
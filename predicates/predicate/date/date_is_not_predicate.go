@@ -26,11 +26,22 @@ func (dateIsNotPrd *DateIsNotPredicate) Schema() schema.Schemable {
 		Description: "Date is not equal predicate",
 		Version:     1,
 		Attributes: map[string]schema.Attributeable{
-			"Value": &attributes.StringAttribute{
-				Required: true,
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date string or Unix epoch number",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+				},
 			},
-			"Arguments": &attributes.StringAttribute{
-				Required: true,
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date, relative date such as now+30d, or an object with date, layout and timezone",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
 			},
 		},
 	}
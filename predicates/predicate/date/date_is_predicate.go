@@ -8,34 +8,19 @@
 package date
 
 import (
-	"time"
-
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/internal/providers/api/schema"
 	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
 )
 
-type DateIsPredicate struct {
-}
+type DateIsPredicate struct{}
 
 func (dateIsPrd *DateIsPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
-	var dateVal string
-	value.As(&dateVal)
-
-	date, err := time.Parse(dateVal, dateVal)
-	if err != nil {
-		return false, err
-	}
-
-	oDateArg := args
-	var oDateVal string
-	oDateArg.As(&oDateVal)
-
-	oDate, err := time.Parse(oDateVal, oDateVal)
+	dates, err := parseDates(value, args, "date")
 	if err != nil {
 		return false, err
 	}
-	return date.Equal(oDate), nil
+	return dates[0].Equal(dates[1]), nil
 }
 
 func (dateIsPrd *DateIsPredicate) Schema() schema.Schemable {
@@ -43,11 +28,22 @@ func (dateIsPrd *DateIsPredicate) Schema() schema.Schemable {
 		Description: "Date is equal predicate",
 		Version:     1,
 		Attributes: map[string]schema.Attributeable{
-			"Value": &attributes.StringAttribute{
-				Required: true,
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date string or Unix epoch number",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+				},
 			},
-			"Arguments": &attributes.StringAttribute{
-				Required: true,
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date, relative date such as now+30d, or an object with date, layout and timezone",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
 			},
 		},
 	}
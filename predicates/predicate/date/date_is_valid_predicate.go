@@ -8,8 +8,6 @@
 package date
 
 import (
-	"time"
-
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/internal/providers/api/schema"
 	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
@@ -18,20 +16,26 @@ import (
 type DateIsValidPredicate struct{}
 
 func (dateIsValidPrd *DateIsValidPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
-	var dateVal string
-	value.As(&dateVal)
-
-	_, err := time.Parse(dateVal, dateVal)
+	_, err := parseDates(value, args)
 	return err == nil, err
 }
 
-func (dateIsNotPrd *DateIsValidPredicate) Schema() schema.Schemable {
+func (dateIsValidPrd *DateIsValidPredicate) Schema() schema.Schemable {
 	return &schema.Schema{
 		Description: "Date is valid predicate",
 		Version:     1,
 		Attributes: map[string]schema.Attributeable{
-			"Value": &attributes.StringAttribute{
-				Required: true,
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date string or Unix epoch number",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+				},
+			},
+			"Arguments": &attributes.MapAttribute{
+				Description:  "Optional layout and timezone",
+				ElementsType: &typed.StringTyped{},
 			},
 		},
 	}
@@ -8,9 +8,6 @@
 package date
 
 import (
-	"fmt"
-	"time"
-
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/internal/providers/api/schema"
 	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
@@ -19,38 +16,11 @@ import (
 type DateIsWithinIntervalPredicate struct{}
 
 func (dateIsWithinIntervalPrd *DateIsWithinIntervalPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
-	var dateVal string
-	value.As(&dateVal)
-
-	date, err := time.Parse(dateVal, dateVal)
-	if err != nil {
-		return false, err
-	}
-
-	argIdx := 0
-	listArg, ok := args.(*typed.ListValue)
-	if !ok {
-		return false, fmt.Errorf("expected a list of arguments, got %T", args)
-	}
-
-	var startDateVal string
-	startDateArg := listArg.Elements[argIdx]
-	startDateArg.As(&startDateVal)
-
-	startDate, err := time.Parse(startDateVal, startDateVal)
-	if err != nil {
-		return false, err
-	}
-
-	argIdx++
-	var endDateVal string
-	endDateArg := listArg.Elements[argIdx]
-	endDateArg.As(&endDateVal)
-	endDate, err := time.Parse(endDateVal, endDateVal)
+	dates, err := parseDates(value, args, "from", "to")
 	if err != nil {
 		return false, err
 	}
-	return !date.Before(startDate) && !date.After(endDate), nil
+	return !dates[0].Before(dates[1]) && !dates[0].After(dates[2]), nil
 }
 
 func (dateIsWithinIntervalPrd *DateIsWithinIntervalPredicate) Schema() schema.Schemable {
@@ -58,12 +28,21 @@ func (dateIsWithinIntervalPrd *DateIsWithinIntervalPredicate) Schema() schema.Sc
 		Description: "Date is within interval predicate",
 		Version:     1,
 		Attributes: map[string]schema.Attributeable{
-			"Value": &attributes.StringAttribute{
-				Required: true,
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Date string or Unix epoch number",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+				},
 			},
-			"Arguments": &attributes.ListAttribute{
-				Required:     true,
-				ElementsType: &typed.StringTyped{},
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "List of start and end dates, or an object with from, to, layout and timezone",
+				VariantsTypes: []typed.Typeable{
+					&typed.ListTyped{ElementsType: &typed.VariantTyped{VariantsTypes: []typed.Typeable{&typed.StringTyped{}, &typed.NumberTyped{}}}},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
 			},
 		},
 	}
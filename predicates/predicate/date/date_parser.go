@@ -0,0 +1,253 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package date
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+)
+
+const (
+	layoutOption   = "layout"
+	timezoneOption = "timezone"
+	relativeNow    = "now"
+
+	// epochMillisThreshold separates epoch seconds from epoch milliseconds,
+	// 1e12 seconds being far beyond any realistic date.
+	epochMillisThreshold = 1e12
+)
+
+var namedLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+}
+
+// detectedLayouts are tried in order when no layout is configured.
+var detectedLayouts = []string{
+	time.RFC3339,
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05Z0700",
+	time.DateTime,
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.ANSIC,
+	time.UnixDate,
+	time.RubyDate,
+}
+
+type dateOptions struct {
+	layout   string
+	location *time.Location
+	now      time.Time
+}
+
+// parseDates parses the value followed by the argument dates. Arguments can be a
+// single date, a list of dates, or an object holding the dates under dateFields
+// along with optional layout and timezone fields. The value must match a configured
+// layout, while argument dates fall back to layout detection.
+func parseDates(value typed.Valuable, args typed.Valuable, dateFields ...string) ([]time.Time, error) {
+	argDates, opts, err := dateArguments(args, dateFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(argDates) != len(dateFields) {
+		return nil, fmt.Errorf("expected %d date arguments, got %d", len(dateFields), len(argDates))
+	}
+
+	date, err := parseDate(value, opts, true)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := []time.Time{date}
+	for _, dateVal := range argDates {
+		if date, err = parseDate(dateVal, opts, false); err != nil {
+			return nil, err
+		}
+		dates = append(dates, date)
+	}
+	return dates, nil
+}
+
+func dateArguments(args typed.Valuable, dateFields []string) ([]typed.Valuable, *dateOptions, error) {
+	opts := &dateOptions{location: time.UTC, now: time.Now()}
+	if args == nil {
+		return nil, opts, nil
+	}
+
+	var fields map[string]typed.Valuable
+	switch argVal := args.(type) {
+	case *typed.MapValue:
+		fields = argVal.Elements
+	case *typed.ObjectValue:
+		fields = argVal.Fields
+	case typed.Elementable:
+		return argVal.Items(), opts, nil
+	default:
+		return []typed.Valuable{args}, opts, nil
+	}
+
+	if err := opts.configure(fields); err != nil {
+		return nil, nil, err
+	}
+
+	dates := make([]typed.Valuable, 0, len(dateFields))
+	for _, dateField := range dateFields {
+		dateVal, ok := fields[dateField]
+		if !ok {
+			return nil, nil, fmt.Errorf("missing '%s' date argument", dateField)
+		}
+		dates = append(dates, dateVal)
+	}
+	return dates, opts, nil
+}
+
+// optionalDateFields returns the given date fields which are present in an object
+// argument, so that predicates can treat them as optional.
+func optionalDateFields(args typed.Valuable, dateFields ...string) []string {
+	var fields map[string]typed.Valuable
+	switch argVal := args.(type) {
+	case *typed.MapValue:
+		fields = argVal.Elements
+	case *typed.ObjectValue:
+		fields = argVal.Fields
+	default:
+		return nil
+	}
+
+	present := make([]string, 0, len(dateFields))
+	for _, dateField := range dateFields {
+		if _, ok := fields[dateField]; ok {
+			present = append(present, dateField)
+		}
+	}
+	return present
+}
+
+func (opts *dateOptions) configure(fields map[string]typed.Valuable) error {
+	if layoutVal, ok := fields[layoutOption]; ok {
+		if layoutVal.Type().Hint().TypeHint() != typed.String {
+			return fmt.Errorf("expected a string layout, got %s", layoutVal.Type().Name())
+		}
+		layoutVal.As(&opts.layout)
+
+		if namedLayout, isNamed := namedLayouts[opts.layout]; isNamed {
+			opts.layout = namedLayout
+		}
+	}
+
+	if timezoneVal, ok := fields[timezoneOption]; ok {
+		if timezoneVal.Type().Hint().TypeHint() != typed.String {
+			return fmt.Errorf("expected a string timezone, got %s", timezoneVal.Type().Name())
+		}
+
+		var timezone string
+		timezoneVal.As(&timezone)
+
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", timezone, err)
+		}
+		opts.location = location
+	}
+	return nil
+}
+
+func parseDate(value typed.Valuable, opts *dateOptions, strictLayout bool) (time.Time, error) {
+	if value == nil {
+		return time.Time{}, fmt.Errorf("date is nil")
+	}
+
+	switch value.Type().Hint().TypeHint() {
+	case typed.Number:
+		var epoch float64
+		value.As(&epoch)
+		return parseEpoch(epoch), nil
+	case typed.String:
+		var dateVal string
+		value.As(&dateVal)
+		return parseDateString(strings.TrimSpace(dateVal), opts, strictLayout)
+	default:
+		return time.Time{}, fmt.Errorf("expected a date string or Unix epoch number, got %s", value.Type().Name())
+	}
+}
+
+func parseEpoch(epoch float64) time.Time {
+	if math.Abs(epoch) >= epochMillisThreshold {
+		return time.UnixMilli(int64(epoch)).UTC()
+	}
+
+	secs, frac := math.Modf(epoch)
+	return time.Unix(int64(secs), int64(frac*float64(time.Second))).UTC()
+}
+
+func parseDateString(dateVal string, opts *dateOptions, strictLayout bool) (time.Time, error) {
+	if strings.HasPrefix(dateVal, relativeNow) {
+		return parseRelativeDate(dateVal, opts.now)
+	}
+
+	if len(opts.layout) > 0 {
+		date, err := time.ParseInLocation(opts.layout, dateVal, opts.location)
+		if err == nil {
+			return date, nil
+		}
+
+		if strictLayout {
+			return time.Time{}, fmt.Errorf("date '%s' doesn't match layout '%s'", dateVal, opts.layout)
+		}
+	}
+
+	for _, layout := range detectedLayouts {
+		if date, err := time.ParseInLocation(layout, dateVal, opts.location); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("couldn't detect the layout of date '%s'", dateVal)
+}
+
+// parseRelativeDate parses expressions such as now, now+30d or now-1y.
+func parseRelativeDate(dateVal string, now time.Time) (time.Time, error) {
+	offset := strings.TrimSpace(strings.TrimPrefix(dateVal, relativeNow))
+	if len(offset) == 0 {
+		return now, nil
+	}
+
+	if offset[0] != '+' && offset[0] != '-' {
+		return time.Time{}, fmt.Errorf("invalid relative date '%s', expected now, now+<duration> or now-<duration>", dateVal)
+	}
+
+	duration, err := util.ParseDuration(offset[:1] + strings.TrimSpace(offset[1:]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid relative date '%s': %w", dateVal, err)
+	}
+	return now.Add(duration), nil
+}
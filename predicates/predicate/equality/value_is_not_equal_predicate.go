@@ -23,7 +23,7 @@ func (valIsNotEqPrd *ValueIsNotEqualPredicate) Test(value typed.Valuable, args t
 
 func (valIsNotEqPrd *ValueIsNotEqualPredicate) Schema() schema.Schemable {
 	return &schema.Schema{
-		Description: "Value equality predicate",
+		Description: "Value inequality predicate",
 		Version:     1,
 		Attributes: map[string]schema.Attributeable{
 			"Value": &attributes.VariantAttribute{
@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package macro
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type Operator string
+
+const (
+	AllOf  Operator = "allOf"
+	AnyOf  Operator = "anyOf"
+	NoneOf Operator = "noneOf"
+)
+
+type Condition struct {
+	Predicate string
+	Arguments any
+}
+
+func (cond *Condition) String() string {
+	if cond.Arguments == nil {
+		return cond.Predicate
+	}
+	return fmt.Sprintf("%s: %v", cond.Predicate, cond.Arguments)
+}
+
+// MacroPredicate is a named predicate defined in a blueprint, composing other
+// predicates with parameters bound from the rule arguments.
+type MacroPredicate struct {
+	Name             string
+	Description      string
+	Parameters       []string
+	Operator         Operator
+	Conditions       []Condition
+	PredicateBuilder predicates.PredicateBuilder
+	findings         []predicates.Finding
+}
+
+func (macroPrd *MacroPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	macroPrd.findings = nil
+	rawArgs, err := functions.RawValueOf(args)
+	if err != nil {
+		return false, err
+	}
+
+	bindings, err := BindArguments(macroPrd.Parameters, rawArgs)
+	if err != nil {
+		return false, fmt.Errorf("predicate '%s': %w", macroPrd.Name, err)
+	}
+
+	satisfiedCount := 0
+	for _, cond := range macroPrd.Conditions {
+		boundCond := Condition{Predicate: cond.Predicate, Arguments: Substitute(cond.Arguments, bindings)}
+		ok, err := macroPrd.testCondition(&boundCond, value)
+		if err != nil {
+			return false, fmt.Errorf("predicate '%s', condition '%s': %w", macroPrd.Name, boundCond.Predicate, err)
+		}
+
+		if ok {
+			satisfiedCount++
+		}
+
+		switch {
+		case macroPrd.Operator == AllOf && !ok:
+			macroPrd.findings = append(macroPrd.findings, predicates.Finding{Message: fmt.Sprintf("doesn't satisfy '%s'", boundCond.String())})
+		case macroPrd.Operator == NoneOf && ok:
+			macroPrd.findings = append(macroPrd.findings, predicates.Finding{Message: fmt.Sprintf("must not satisfy '%s'", boundCond.String())})
+		}
+	}
+
+	switch macroPrd.Operator {
+	case AllOf:
+		return satisfiedCount == len(macroPrd.Conditions), nil
+	case AnyOf:
+		if satisfiedCount == 0 {
+			macroPrd.findings = append(macroPrd.findings, predicates.Finding{Message: "doesn't satisfy any of the conditions"})
+		}
+		return satisfiedCount > 0, nil
+	case NoneOf:
+		return satisfiedCount == 0, nil
+	default:
+		return false, fmt.Errorf("predicate '%s': unsupported operator '%s'", macroPrd.Name, macroPrd.Operator)
+	}
+}
+
+func (macroPrd *MacroPredicate) testCondition(cond *Condition, value typed.Valuable) (bool, error) {
+	predicate, err := macroPrd.PredicateBuilder.BuildByName(cond.Predicate)
+	if err != nil {
+		return false, err
+	}
+
+	var argVal typed.Valuable
+	if cond.Arguments != nil {
+		if argVal, err = functions.ParseRawValue(cond.Arguments); err != nil {
+			return false, err
+		}
+	}
+
+	ok, err := predicate.Test(value, argVal)
+	if findingsReporter, isReporter := predicate.(predicates.FindingsReporter); isReporter {
+		macroPrd.findings = append(macroPrd.findings, findingsReporter.Findings()...)
+	}
+	return ok, err
+}
+
+func (macroPrd *MacroPredicate) Findings() []predicates.Finding {
+	return macroPrd.findings
+}
+
+func (macroPrd *MacroPredicate) Schema() schema.Schemable {
+	description := macroPrd.Description
+	if len(description) == 0 {
+		description = fmt.Sprintf("Blueprint defined '%s' predicate", macroPrd.Name)
+	}
+
+	prdSchema := &schema.Schema{
+		Description: description,
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.VariantAttribute{
+				Required: true,
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+					&typed.BooleanTyped{},
+					&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
+			},
+		},
+	}
+
+	if len(macroPrd.Parameters) > 0 {
+		prdSchema.Attributes["Arguments"] = &attributes.MapAttribute{
+			Required:     true,
+			Description:  fmt.Sprintf("Parameters: %v", macroPrd.Parameters),
+			ElementsType: &typed.GenericTyped{},
+		}
+	}
+	return prdSchema
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package macro
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// placeholderExp matches parameter placeholders such as {{ domain }}.
+var placeholderExp = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// Placeholders returns the names of the parameters referenced in raw, sorted and deduplicated.
+func Placeholders(raw any) []string {
+	found := map[string]struct{}{}
+	walkStrings(raw, func(s string) {
+		for _, match := range placeholderExp.FindAllStringSubmatch(s, -1) {
+			found[match[1]] = struct{}{}
+		}
+	})
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func walkStrings(raw any, fn func(string)) {
+	switch rawVal := raw.(type) {
+	case string:
+		fn(rawVal)
+	case []any:
+		for _, item := range rawVal {
+			walkStrings(item, fn)
+		}
+	case map[string]any:
+		for _, item := range rawVal {
+			walkStrings(item, fn)
+		}
+	case map[any]any:
+		for _, item := range rawVal {
+			walkStrings(item, fn)
+		}
+	}
+}
+
+// Substitute replaces parameter placeholders in raw with the bound values. A string
+// consisting of a single placeholder is replaced by the value itself, so lists,
+// numbers and objects keep their type, while placeholders within text are formatted.
+func Substitute(raw any, bindings map[string]any) any {
+	switch rawVal := raw.(type) {
+	case string:
+		if match := placeholderExp.FindStringSubmatch(strings.TrimSpace(rawVal)); match != nil && match[0] == strings.TrimSpace(rawVal) {
+			return bindings[match[1]]
+		}
+
+		return placeholderExp.ReplaceAllStringFunc(rawVal, func(placeholder string) string {
+			name := placeholderExp.FindStringSubmatch(placeholder)[1]
+			return fmt.Sprintf("%v", bindings[name])
+		})
+	case []any:
+		items := make([]any, 0, len(rawVal))
+		for _, item := range rawVal {
+			items = append(items, Substitute(item, bindings))
+		}
+		return items
+	case map[string]any:
+		fields := make(map[string]any, len(rawVal))
+		for key, item := range rawVal {
+			fields[key] = Substitute(item, bindings)
+		}
+		return fields
+	case map[any]any:
+		fields := make(map[string]any, len(rawVal))
+		for key, item := range rawVal {
+			fields[fmt.Sprintf("%v", key)] = Substitute(item, bindings)
+		}
+		return fields
+	default:
+		return raw
+	}
+}
+
+// BindArguments maps rule arguments to parameters. Arguments are an object keyed by
+// parameter name, while a predicate with a single parameter also accepts its value directly.
+func BindArguments(parameters []string, args any) (map[string]any, error) {
+	bindings := make(map[string]any, len(parameters))
+	if len(parameters) == 0 {
+		if args != nil {
+			return nil, fmt.Errorf("predicate doesn't take arguments")
+		}
+		return bindings, nil
+	}
+
+	fields, isObject := objectFields(args)
+	if len(parameters) == 1 && (!isObject || len(fields) != 1 || fields[parameters[0]] == nil) {
+		if args == nil {
+			return nil, fmt.Errorf("missing argument for parameter '%s'", parameters[0])
+		}
+		bindings[parameters[0]] = args
+		return bindings, nil
+	}
+
+	if !isObject {
+		return nil, fmt.Errorf("expected an object with parameters %s as argument", strings.Join(parameters, ", "))
+	}
+
+	declared := make(map[string]struct{}, len(parameters))
+	for _, param := range parameters {
+		declared[param] = struct{}{}
+		value, found := fields[param]
+		if !found || value == nil {
+			return nil, fmt.Errorf("missing argument for parameter '%s'", param)
+		}
+		bindings[param] = value
+	}
+
+	for name := range fields {
+		if _, found := declared[name]; !found {
+			return nil, fmt.Errorf("unknown parameter '%s'", name)
+		}
+	}
+	return bindings, nil
+}
+
+func objectFields(raw any) (map[string]any, bool) {
+	switch rawVal := raw.(type) {
+	case map[string]any:
+		return rawVal, true
+	case map[any]any:
+		fields := make(map[string]any, len(rawVal))
+		for key, value := range rawVal {
+			fields[fmt.Sprintf("%v", key)] = value
+		}
+		return fields, true
+	default:
+		return nil, false
+	}
+}
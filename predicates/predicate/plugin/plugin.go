@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/conformize/conformize/common/util"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+)
+
+const (
+	DefaultTimeout = 10 * time.Second
+
+	maxStderrLength = 1024
+	waitDelay       = time.Second
+)
+
+type Plugin struct {
+	Name    string
+	Path    string
+	Args    []string
+	Timeout time.Duration
+}
+
+var schemasCache sync.Map
+
+// New resolves the plugin executable relative to the blueprint working directory.
+// An empty timeout falls back to DefaultTimeout.
+func New(name string, path string, args []string, timeout string) (*Plugin, error) {
+	if len(strings.TrimSpace(path)) == 0 {
+		return nil, fmt.Errorf("plugin executable path not specified")
+	}
+
+	execPath, err := util.ResolveFilePath(path)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't resolve plugin executable %s: %w", path, err)
+	}
+
+	info, err := os.Stat(execPath)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't resolve plugin executable %s: %w", path, err)
+	}
+
+	if info.IsDir() || (runtime.GOOS != "windows" && info.Mode().Perm()&0o111 == 0) {
+		return nil, fmt.Errorf("plugin %s is not an executable file", path)
+	}
+
+	pluginTimeout := DefaultTimeout
+	if len(strings.TrimSpace(timeout)) > 0 {
+		if pluginTimeout, err = util.ParseDuration(timeout); err != nil {
+			return nil, err
+		}
+
+		if pluginTimeout <= 0 {
+			return nil, fmt.Errorf("expected a positive timeout, got %s", timeout)
+		}
+	}
+
+	return &Plugin{
+		Name:    name,
+		Path:    execPath,
+		Args:    args,
+		Timeout: pluginTimeout,
+	}, nil
+}
+
+// Schema asks the plugin to describe its predicate. The result is cached for the
+// lifetime of the process, so validating and applying a blueprint describes a plugin once.
+func (p *Plugin) Schema() (schema.Schemable, error) {
+	cacheKey := strings.Join(append([]string{p.Name, p.Path}, p.Args...), "\x00")
+	if cached, ok := schemasCache.Load(cacheKey); ok {
+		return cached.(schema.Schemable), nil
+	}
+
+	var resp describeResponse
+	if err := p.invoke(&request{Command: describeCommand, Predicate: p.Name}, &resp); err != nil {
+		return nil, err
+	}
+
+	prdSchema, err := schemaFromDescription(&resp)
+	if err != nil {
+		return nil, fmt.Errorf("plugin '%s' returned an invalid description: %w", p.Name, err)
+	}
+
+	schemasCache.Store(cacheKey, prdSchema)
+	return prdSchema, nil
+}
+
+func (p *Plugin) test(value any, args any) (*testResponse, error) {
+	var resp testResponse
+	if err := p.invoke(&request{Command: testCommand, Predicate: p.Name, Value: value, Arguments: args}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// invoke runs the plugin in a process of its own for every request, so a crashing
+// or hanging plugin can't take down the evaluation of other rules.
+func (p *Plugin) invoke(req *request, resp any) error {
+	req.ProtocolVersion = ProtocolVersion
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("couldn't encode request for plugin '%s': %w", p.Name, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.Timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Path, p.Args...)
+	cmd.Dir = util.GetWorkDir()
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+
+	if err = cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("plugin '%s' timed out after %s", p.Name, p.Timeout)
+		}
+		return fmt.Errorf("plugin '%s' failed: %w%s", p.Name, err, stderrDetails(&stderr))
+	}
+
+	if err = json.Unmarshal(stdout.Bytes(), resp); err != nil {
+		return fmt.Errorf("plugin '%s' returned an invalid response: %w", p.Name, err)
+	}
+	return nil
+}
+
+func stderrDetails(stderr *bytes.Buffer) string {
+	details := strings.TrimSpace(stderr.String())
+	if len(details) == 0 {
+		return ""
+	}
+
+	if len(details) > maxStderrLength {
+		details = details[:maxStderrLength] + "..."
+	}
+	return ": " + strings.Join(strings.Fields(details), " ")
+}
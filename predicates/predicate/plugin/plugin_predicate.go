@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/predicates"
+)
+
+type PluginPredicate struct {
+	Plugin   *Plugin
+	findings []predicates.Finding
+}
+
+func (pluginPrd *PluginPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	if value == nil {
+		return false, fmt.Errorf("value is nil")
+	}
+
+	rawValue, err := functions.RawValueOf(value)
+	if err != nil {
+		return false, err
+	}
+
+	rawArgs, err := functions.RawValueOf(args)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := pluginPrd.Plugin.test(rawValue, rawArgs)
+	if err != nil {
+		return false, err
+	}
+
+	if len(resp.Error) > 0 {
+		return false, fmt.Errorf("plugin '%s': %s", pluginPrd.Plugin.Name, resp.Error)
+	}
+
+	for _, f := range resp.Findings {
+		pluginPrd.findings = append(pluginPrd.findings, predicates.Finding{Path: f.Path, Message: f.Message})
+	}
+	return resp.Result, nil
+}
+
+func (pluginPrd *PluginPredicate) Findings() []predicates.Finding {
+	return pluginPrd.findings
+}
+
+func (pluginPrd *PluginPredicate) Schema() schema.Schemable {
+	prdSchema, err := pluginPrd.Plugin.Schema()
+	if err != nil {
+		return &schema.Schema{
+			Description: fmt.Sprintf("Plugin '%s' predicate, description unavailable: %s", pluginPrd.Plugin.Name, err),
+			Version:     1,
+		}
+	}
+	return prdSchema
+}
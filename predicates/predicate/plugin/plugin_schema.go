@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+func schemaFromDescription(desc *describeResponse) (schema.Schemable, error) {
+	prdSchema := &schema.Schema{
+		Description: desc.Description,
+		Version:     1,
+		Attributes:  map[string]schema.Attributeable{},
+	}
+
+	valueDesc := desc.Value
+	if valueDesc == nil {
+		valueDesc = &attributeDescription{Required: true}
+	}
+
+	valueAttr, err := attributeFromDescription(valueDesc)
+	if err != nil {
+		return nil, fmt.Errorf("value: %w", err)
+	}
+	prdSchema.Attributes["Value"] = valueAttr
+
+	if desc.Arguments != nil {
+		argsAttr, err := attributeFromDescription(desc.Arguments)
+		if err != nil {
+			return nil, fmt.Errorf("arguments: %w", err)
+		}
+		prdSchema.Attributes["Arguments"] = argsAttr
+	}
+	return prdSchema, nil
+}
+
+func attributeFromDescription(desc *attributeDescription) (schema.Attributeable, error) {
+	switch strings.ToLower(desc.Type) {
+	case "string":
+		return &attributes.StringAttribute{Required: desc.Required, Description: desc.Description}, nil
+	case "number":
+		return &attributes.NumberAttribute{Required: desc.Required, Description: desc.Description}, nil
+	case "boolean":
+		return &attributes.BooleanAttribute{Required: desc.Required, Description: desc.Description}, nil
+	case "list":
+		return &attributes.ListAttribute{
+			Required:     desc.Required,
+			Description:  desc.Description,
+			ElementsType: &typed.GenericTyped{},
+		}, nil
+	case "map", "object":
+		return &attributes.MapAttribute{
+			Required:     desc.Required,
+			Description:  desc.Description,
+			ElementsType: &typed.GenericTyped{},
+		}, nil
+	case "", "any":
+		return &attributes.VariantAttribute{
+			Required:    desc.Required,
+			Description: desc.Description,
+			VariantsTypes: []typed.Typeable{
+				&typed.StringTyped{},
+				&typed.NumberTyped{},
+				&typed.BooleanTyped{},
+				&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+				&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type '%s'", desc.Type)
+	}
+}
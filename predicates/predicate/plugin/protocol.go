@@ -0,0 +1,48 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package plugin
+
+// ProtocolVersion is sent with every request, so that plugins can reject
+// requests they don't understand.
+const ProtocolVersion = 1
+
+const (
+	describeCommand = "describe"
+	testCommand     = "test"
+)
+
+type request struct {
+	ProtocolVersion int    `json:"protocolVersion"`
+	Command         string `json:"command"`
+	Predicate       string `json:"predicate,omitempty"`
+	Value           any    `json:"value,omitempty"`
+	Arguments       any    `json:"arguments,omitempty"`
+}
+
+type attributeDescription struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+}
+
+type describeResponse struct {
+	Description string                `json:"description"`
+	Value       *attributeDescription `json:"value"`
+	Arguments   *attributeDescription `json:"arguments"`
+}
+
+type finding struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+type testResponse struct {
+	Result   bool      `json:"result"`
+	Findings []finding `json:"findings"`
+	Error    string    `json:"error"`
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/conformize/conformize/common/util"
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+)
+
+const (
+	regoFileExt     = ".rego"
+	regoTestFileExt = "_test.rego"
+	defaultRule     = "deny"
+	inlineModule    = "inline.rego"
+)
+
+// networkBuiltins are unavailable to policies, so evaluation never leaves the machine.
+var networkBuiltins = map[string]struct{}{
+	"http.send":          {},
+	"net.lookup_ip_addr": {},
+}
+
+type regoPolicy struct {
+	query    rego.PreparedEvalQuery
+	queryStr string
+}
+
+var preparedPolicies sync.Map
+
+type policySource struct {
+	name    string
+	content string
+}
+
+// loadPolicySources reads the policy files, or all non-test .rego files in policy
+// directories, resolved relative to the blueprint working directory.
+func loadPolicySources(policyPaths []string, module string) ([]policySource, error) {
+	var sources []policySource
+	for _, policyPath := range policyPaths {
+		resolvedPath, err := util.ResolveFilePath(policyPath)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't resolve policy %s: %w", policyPath, err)
+		}
+
+		info, err := os.Stat(resolvedPath)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't resolve policy %s: %w", policyPath, err)
+		}
+
+		files := []string{resolvedPath}
+		if info.IsDir() {
+			if files, err = regoFilesIn(resolvedPath); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't read policy %s: %w", file, err)
+			}
+			sources = append(sources, policySource{name: file, content: string(content)})
+		}
+	}
+
+	if len(strings.TrimSpace(module)) > 0 {
+		sources = append(sources, policySource{name: inlineModule, content: module})
+	}
+
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no policy specified")
+	}
+	return sources, nil
+}
+
+func regoFilesIn(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() && strings.HasSuffix(path, regoFileExt) && !strings.HasSuffix(path, regoTestFileExt) {
+			files = append(files, path)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read policies in %s: %w", dir, err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no policies found in %s", dir)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// parseModule accepts both the original Rego syntax and the Rego v1 syntax.
+func parseModule(source policySource) (*ast.Module, error) {
+	module, err := ast.ParseModuleWithOpts(source.name, source.content, ast.ParserOptions{RegoVersion: ast.RegoV0})
+	if err == nil {
+		return module, nil
+	}
+
+	if moduleV1, errV1 := ast.ParseModuleWithOpts(source.name, source.content, ast.ParserOptions{RegoVersion: ast.RegoV1}); errV1 == nil {
+		return moduleV1, nil
+	}
+	return nil, fmt.Errorf("couldn't parse policy %s: %w", source.name, err)
+}
+
+// prepareRegoPolicy compiles the policy once per distinct set of sources and query,
+// as rules may evaluate the same policy for many values.
+func prepareRegoPolicy(sources []policySource, query string) (*regoPolicy, error) {
+	var cacheKey strings.Builder
+	cacheKey.WriteString(query)
+	for _, source := range sources {
+		cacheKey.WriteString("\x00" + source.name + "\x00" + source.content)
+	}
+
+	if cached, ok := preparedPolicies.Load(cacheKey.String()); ok {
+		return cached.(*regoPolicy), nil
+	}
+
+	options := make([]func(*rego.Rego), 0, len(sources)+2)
+	modules := make(map[string]*ast.Module, len(sources))
+	var firstModule *ast.Module
+	for _, source := range sources {
+		module, err := parseModule(source)
+		if err != nil {
+			return nil, err
+		}
+
+		if firstModule == nil {
+			firstModule = module
+		}
+		modules[source.name] = module
+		options = append(options, rego.ParsedModule(module))
+	}
+
+	if len(strings.TrimSpace(query)) == 0 {
+		query = firstModule.Package.Path.String() + "." + defaultRule
+	}
+
+	options = append(options, rego.Query(query), rego.UnsafeBuiltins(networkBuiltins))
+	preparedQuery, err := rego.New(options...).PrepareForEval(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't prepare policy query %s: %w", query, err)
+	}
+
+	if err := checkQueryRules(modules, query); err != nil {
+		return nil, err
+	}
+
+	policy := &regoPolicy{query: preparedQuery, queryStr: query}
+	preparedPolicies.Store(cacheKey.String(), policy)
+	return policy, nil
+}
+
+// checkQueryRules makes sure every data document the query refers to is defined by a
+// policy rule, as a misspelled rule would otherwise evaluate to undefined.
+func checkQueryRules(modules map[string]*ast.Module, query string) error {
+	body, err := ast.ParseBody(query)
+	if err != nil {
+		return fmt.Errorf("couldn't parse policy query %s: %w", query, err)
+	}
+
+	compiler := ast.NewCompiler()
+	if compiler.Compile(modules); compiler.Failed() {
+		return fmt.Errorf("couldn't compile policy: %w", compiler.Errors)
+	}
+
+	var undefinedRef ast.Ref
+	ast.WalkRefs(body, func(ref ast.Ref) bool {
+		if undefinedRef != nil || !ref.HasPrefix(ast.DefaultRootRef) {
+			return false
+		}
+
+		prefix := ref.GroundPrefix()
+		if len(compiler.GetRulesWithPrefix(prefix)) == 0 && len(compiler.GetRulesForVirtualDocument(prefix)) == 0 {
+			undefinedRef = prefix
+		}
+		return false
+	})
+
+	if undefinedRef != nil {
+		return fmt.Errorf("policy query %s refers to %s, which isn't defined by any policy rule", query, undefinedRef)
+	}
+	return nil
+}
+
+// evaluate returns the violations reported by the query: a boolean result reports a
+// single violation when false, while a set or array of messages - or of objects with
+// a msg field and an optional path - reports one violation per message. An undefined
+// result reports a violation, so that policies never pass by not being evaluated.
+func (policy *regoPolicy) evaluate(input any) (bool, []violation, error) {
+	results, err := policy.query.Eval(context.Background(), rego.EvalInput(input))
+	if err != nil {
+		return false, nil, fmt.Errorf("couldn't evaluate policy query %s: %w", policy.queryStr, err)
+	}
+
+	if len(results) == 0 {
+		return false, []violation{{message: fmt.Sprintf("%s is undefined", policy.queryStr)}}, nil
+	}
+
+	var violations []violation
+	for _, result := range results {
+		for _, expr := range result.Expressions {
+			switch exprVal := expr.Value.(type) {
+			case bool:
+				if !exprVal {
+					violations = append(violations, violation{message: fmt.Sprintf("%s is false", policy.queryStr)})
+				}
+			case []any:
+				for _, item := range exprVal {
+					v, err := violationOf(item)
+					if err != nil {
+						return false, nil, err
+					}
+					violations = append(violations, v)
+				}
+			default:
+				return false, nil, fmt.Errorf("expected query %s to evaluate to a boolean or a set of messages, got %T", policy.queryStr, expr.Value)
+			}
+		}
+	}
+	return len(violations) == 0, violations, nil
+}
+
+type violation struct {
+	path    string
+	message string
+}
+
+func violationOf(item any) (violation, error) {
+	switch itemVal := item.(type) {
+	case string:
+		return violation{message: itemVal}, nil
+	case map[string]any:
+		msg, ok := itemVal["msg"].(string)
+		if !ok {
+			return violation{}, fmt.Errorf("expected violation object to have a msg field")
+		}
+
+		path, _ := itemVal["path"].(string)
+		return violation{path: path, message: msg}, nil
+	default:
+		return violation{message: fmt.Sprintf("%v", itemVal)}, nil
+	}
+}
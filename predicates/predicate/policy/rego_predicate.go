@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package policy
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type RegoPredicate struct {
+	findings []predicates.Finding
+}
+
+type regoArgs struct {
+	policies []string
+	module   string
+	query    string
+}
+
+func (regoPrd *RegoPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	if value == nil {
+		return false, fmt.Errorf("value is nil")
+	}
+
+	policyArgs, err := regoArgsOf(args)
+	if err != nil {
+		return false, err
+	}
+
+	sources, err := loadPolicySources(policyArgs.policies, policyArgs.module)
+	if err != nil {
+		return false, err
+	}
+
+	policy, err := prepareRegoPolicy(sources, policyArgs.query)
+	if err != nil {
+		return false, err
+	}
+
+	input, err := functions.RawValueOf(value)
+	if err != nil {
+		return false, err
+	}
+
+	ok, violations, err := policy.evaluate(input)
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range violations {
+		regoPrd.findings = append(regoPrd.findings, predicates.Finding{Path: v.path, Message: v.message})
+	}
+	return ok, nil
+}
+
+// regoArgsOf accepts a policy path, or an object with policy - a path or a list of
+// paths to files or directories, module - an inline policy, and query.
+func regoArgsOf(args typed.Valuable) (*regoArgs, error) {
+	if args == nil {
+		return nil, fmt.Errorf("argument is nil")
+	}
+
+	rawArgs, err := functions.RawValueOf(args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch argsVal := rawArgs.(type) {
+	case string:
+		return &regoArgs{policies: []string{argsVal}}, nil
+	case map[string]any:
+		policyArgs := &regoArgs{}
+		switch policyVal := argsVal["policy"].(type) {
+		case nil:
+		case string:
+			policyArgs.policies = []string{policyVal}
+		case []any:
+			for _, p := range policyVal {
+				policyPath, ok := p.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected policy paths to be strings")
+				}
+				policyArgs.policies = append(policyArgs.policies, policyPath)
+			}
+		default:
+			return nil, fmt.Errorf("expected policy to be a path or a list of paths")
+		}
+
+		var ok bool
+		if policyArgs.module, ok = stringField(argsVal, "module"); !ok {
+			return nil, fmt.Errorf("expected module to be a string")
+		}
+
+		if policyArgs.query, ok = stringField(argsVal, "query"); !ok {
+			return nil, fmt.Errorf("expected query to be a string")
+		}
+		return policyArgs, nil
+	default:
+		return nil, fmt.Errorf("expected a policy path or an object as argument, got %s", args.Type().Name())
+	}
+}
+
+func stringField(fields map[string]any, name string) (string, bool) {
+	field, found := fields[name]
+	if !found || field == nil {
+		return "", true
+	}
+
+	s, ok := field.(string)
+	return s, ok
+}
+
+func (regoPrd *RegoPredicate) Findings() []predicates.Finding {
+	return regoPrd.findings
+}
+
+func (regoPrd *RegoPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Value satisfies Rego policy predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Value evaluated as the policy input",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+					&typed.BooleanTyped{},
+					&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Policy path, or an object with policy (path or list of paths), module (inline policy) and query, data.<package>.deny by default",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package primitive
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type NumberIsApproxEqualPredicate[T float64] struct{}
+
+func (numApproxEqPrd *NumberIsApproxEqualPredicate[T]) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	v, err := numberFromValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	if args == nil {
+		return false, fmt.Errorf("arguments are nil")
+	}
+
+	listArg, ok := args.(*typed.ListValue)
+	if !ok {
+		return false, fmt.Errorf("expected a list of expected value and tolerance as arguments, got %s", args.Type().Name())
+	}
+
+	if len(listArg.Elements) != 2 {
+		return false, fmt.Errorf("expected exactly 2 arguments, expected value and tolerance, got %d", len(listArg.Elements))
+	}
+
+	expected, err := numberFromArgs(listArg.Elements[0])
+	if err != nil {
+		return false, fmt.Errorf("invalid expected value: %w", err)
+	}
+
+	tolerance, err := numberFromArgs(listArg.Elements[1])
+	if err != nil {
+		return false, fmt.Errorf("invalid tolerance: %w", err)
+	}
+
+	if tolerance < 0 {
+		return false, fmt.Errorf("tolerance cannot be negative, got %v", tolerance)
+	}
+	return math.Abs(v-expected) <= tolerance, nil
+}
+
+func (numApproxEqPrd *NumberIsApproxEqualPredicate[T]) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Number is approximately equal predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.NumberAttribute{
+				Required: true,
+			},
+			"Arguments": &attributes.TupleAttribute{
+				Required:    true,
+				Description: "Expected value and absolute tolerance, e.g. [0.75, 0.01]",
+				ElementsTypes: []typed.Typeable{
+					&typed.NumberTyped{},
+					&typed.NumberTyped{},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package primitive
+
+import (
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type NumberIsIntegerPredicate[T float64] struct{}
+
+func (numIsIntPrd *NumberIsIntegerPredicate[T]) Test(value typed.Valuable, _ typed.Valuable) (bool, error) {
+	v, err := numberFromValue(value)
+	if err != nil {
+		return false, err
+	}
+	return isIntegral(v), nil
+}
+
+func (numIsIntPrd *NumberIsIntegerPredicate[T]) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Number is integer predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.NumberAttribute{
+				Required: true,
+			},
+		},
+	}
+}
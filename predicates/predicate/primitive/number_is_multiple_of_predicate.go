@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package primitive
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type NumberIsMultipleOfPredicate[T float64] struct{}
+
+func (numMultipleOfPrd *NumberIsMultipleOfPredicate[T]) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	v, err := numberFromValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	divisor, err := numberFromArgs(args)
+	if err != nil {
+		return false, err
+	}
+
+	if divisor == 0 {
+		return false, fmt.Errorf("divisor cannot be zero")
+	}
+	return isNearlyIntegral(v / divisor), nil
+}
+
+func (numMultipleOfPrd *NumberIsMultipleOfPredicate[T]) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Number is multiple of predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.NumberAttribute{
+				Required: true,
+			},
+			"Arguments": &attributes.NumberAttribute{
+				Required:    true,
+				Description: "Non-zero divisor, e.g. 0.25 or 1024",
+			},
+		},
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package primitive
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+const (
+	percentScale  = "percent"
+	fractionScale = "fraction"
+)
+
+type NumberIsPercentagePredicate[T float64] struct{}
+
+func (numIsPercentPrd *NumberIsPercentagePredicate[T]) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	v, err := numberFromValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	scale := percentScale
+	if args != nil {
+		if args.Type().Hint().TypeHint() != typed.String {
+			return false, fmt.Errorf("expected a string argument, got %s", args.Type().Name())
+		}
+		args.As(&scale)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(scale)) {
+	case percentScale:
+		return v >= 0 && v <= 100, nil
+	case fractionScale, "ratio":
+		return v >= 0 && v <= 1, nil
+	default:
+		return false, fmt.Errorf("unsupported percentage scale '%s', expected '%s' or '%s'", scale, percentScale, fractionScale)
+	}
+}
+
+func (numIsPercentPrd *NumberIsPercentagePredicate[T]) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Number is percentage predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.NumberAttribute{
+				Required: true,
+			},
+			"Arguments": &attributes.StringAttribute{
+				Required:    false,
+				Description: "Scale of the percentage: 'percent' for 0..100 (default) or 'fraction' for 0..1",
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package primitive
+
+import (
+	"math"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type NumberIsPowerOfTwoPredicate[T float64] struct{}
+
+func (numIsPow2Prd *NumberIsPowerOfTwoPredicate[T]) Test(value typed.Valuable, _ typed.Valuable) (bool, error) {
+	v, err := numberFromValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	if v < 1 || !isIntegral(v) {
+		return false, nil
+	}
+
+	frac, _ := math.Frexp(v)
+	return frac == 0.5, nil
+}
+
+func (numIsPow2Prd *NumberIsPowerOfTwoPredicate[T]) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Number is power of two predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.NumberAttribute{
+				Required: true,
+			},
+		},
+	}
+}
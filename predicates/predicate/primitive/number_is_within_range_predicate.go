@@ -75,9 +75,13 @@ func (numWithinRangePrd *NumberIsWithinRangePredicate[T]) Schema() schema.Schema
 			"Value": &attributes.NumberAttribute{
 				Required: true,
 			},
-			"Arguments": &attributes.ListAttribute{
-				Required:     true,
-				ElementsType: &typed.NumberTyped{},
+			"Arguments": &attributes.TupleAttribute{
+				Required: true,
+				ElementsTypes: []typed.Typeable{
+					&typed.NumberTyped{},
+					&typed.NumberTyped{},
+				},
+				Description: "Lower and upper bounds",
 			},
 		},
 	}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package primitive
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type NumberMaxDecimalPlacesPredicate[T float64] struct{}
+
+func (numMaxDecimalsPrd *NumberMaxDecimalPlacesPredicate[T]) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	v, err := numberFromValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	places, err := numberFromArgs(args)
+	if err != nil {
+		return false, err
+	}
+
+	if places < 0 || !isIntegral(places) {
+		return false, fmt.Errorf("expected a non-negative integer as decimal places, got %v", places)
+	}
+
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return false, nil
+	}
+	return decimalPlaces(v) <= int(places), nil
+}
+
+// decimalPlaces counts the fractional digits of the shortest representation
+// that round-trips to v, so 0.1 counts as one place rather than its binary expansion.
+func decimalPlaces(v float64) int {
+	repr := strconv.FormatFloat(v, 'f', -1, 64)
+	if _, fraction, found := strings.Cut(repr, "."); found {
+		return len(fraction)
+	}
+	return 0
+}
+
+func (numMaxDecimalsPrd *NumberMaxDecimalPlacesPredicate[T]) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Number has at most N decimal places predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.NumberAttribute{
+				Required: true,
+			},
+			"Arguments": &attributes.NumberAttribute{
+				Required:    true,
+				Description: "Maximum number of decimal places",
+			},
+		},
+	}
+}
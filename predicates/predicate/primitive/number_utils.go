@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package primitive
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/conformize/conformize/common/typed"
+)
+
+// floatTolerance absorbs binary floating point error when checking whether a
+// float64 holds an integral or exact decimal result.
+const floatTolerance = 1e-9
+
+func numberFromValue(value typed.Valuable) (float64, error) {
+	if value == nil {
+		return 0, fmt.Errorf("value is nil")
+	}
+
+	if value.Type().Hint().TypeHint() != typed.Number {
+		return 0, fmt.Errorf("expected a number value, got %s", value.Type().Name())
+	}
+
+	var v float64
+	if err := value.As(&v); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+func numberFromArgs(args typed.Valuable) (float64, error) {
+	if args == nil {
+		return 0, fmt.Errorf("argument is nil")
+	}
+
+	if args.Type().Hint().TypeHint() != typed.Number {
+		return 0, fmt.Errorf("expected a number argument, got %s", args.Type().Name())
+	}
+
+	var v float64
+	if err := args.As(&v); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+func isIntegral(v float64) bool {
+	return !math.IsInf(v, 0) && !math.IsNaN(v) && math.Trunc(v) == v
+}
+
+func isNearlyIntegral(v float64) bool {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return false
+	}
+	return math.Abs(v-math.Round(v)) <= floatTolerance*math.Max(1, math.Abs(v))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package reference
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type ExistsInPredicate struct {
+	findings []predicates.Finding
+}
+
+func (existsInPrd *ExistsInPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	if args == nil {
+		return false, fmt.Errorf("argument is nil")
+	}
+
+	var targetValues []typed.Valuable
+	switch argVal := args.(type) {
+	case *typed.MapValue:
+		targetValues = make([]typed.Valuable, 0, len(argVal.Elements))
+		for _, key := range functions.SortedKeys(argVal.Elements) {
+			targetValues = append(targetValues, argVal.Elements[key])
+		}
+	case typed.Elementable:
+		targetValues = argVal.Items()
+	default:
+		targetValues = []typed.Valuable{args}
+	}
+
+	targets := make(map[string]struct{}, len(targetValues))
+	for _, targetVal := range targetValues {
+		target, err := referenceValue(targetVal)
+		if err != nil {
+			return false, err
+		}
+		targets[target] = struct{}{}
+	}
+	return checkReferences(&existsInPrd.findings, value, targets, "dangling reference '%s', not found in the referenced values")
+}
+
+func (existsInPrd *ExistsInPredicate) Findings() []predicates.Finding {
+	return existsInPrd.findings
+}
+
+func (existsInPrd *ExistsInPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Value references existing values predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Reference, list of references, or object whose keys are references",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
+			},
+			"Arguments": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Path to the referenced values",
+				VariantsTypes: []typed.Typeable{
+					&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.StringTyped{},
+					&typed.NumberTyped{},
+					&typed.BooleanTyped{},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package reference
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type KeyOfPredicate struct {
+	findings []predicates.Finding
+}
+
+func (keyOfPrd *KeyOfPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	if args == nil {
+		return false, fmt.Errorf("argument is nil")
+	}
+
+	fields, ok := functions.FieldsOf(args)
+	if !ok {
+		return false, fmt.Errorf("expected an object or map as argument, got %s", args.Type().Name())
+	}
+
+	keys := make(map[string]struct{}, len(fields))
+	for key := range fields {
+		keys[key] = struct{}{}
+	}
+	return checkReferences(&keyOfPrd.findings, value, keys, "dangling reference '%s', no such key in the referenced object")
+}
+
+func (keyOfPrd *KeyOfPredicate) Findings() []predicates.Finding {
+	return keyOfPrd.findings
+}
+
+func (keyOfPrd *KeyOfPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Value references keys of another object predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.VariantAttribute{
+				Required:    true,
+				Description: "Reference, list of references, or object whose keys are references",
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
+			},
+			"Arguments": &attributes.MapAttribute{
+				Required:     true,
+				Description:  "Path to the referenced object",
+				ElementsType: &typed.GenericTyped{},
+			},
+		},
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package reference
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/typed/functions"
+	"github.com/conformize/conformize/predicates"
+)
+
+type reference struct {
+	steps path.Steps
+	value string
+}
+
+// referencesOf returns the references held by value: the value itself when it's a
+// primitive, the elements of a list or tuple, or the keys of a map or object.
+func referencesOf(value typed.Valuable) ([]reference, error) {
+	if value == nil {
+		return nil, fmt.Errorf("value is nil")
+	}
+
+	if fields, ok := functions.FieldsOf(value); ok {
+		refs := make([]reference, 0, len(fields))
+		for _, key := range functions.SortedKeys(fields) {
+			steps := path.Steps{}
+			steps.Add(path.KeyStep(key))
+			refs = append(refs, reference{steps: steps, value: key})
+		}
+		return refs, nil
+	}
+
+	if elemVal, ok := value.(typed.Elementable); ok {
+		refs := make([]reference, 0, elemVal.Length())
+		for idx, elem := range elemVal.Items() {
+			refVal, err := referenceValue(elem)
+			if err != nil {
+				return nil, err
+			}
+
+			steps := path.Steps{}
+			steps.Add(path.IndexStep(strconv.Itoa(idx)))
+			refs = append(refs, reference{steps: steps, value: refVal})
+		}
+		return refs, nil
+	}
+
+	refVal, err := referenceValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return []reference{{value: refVal}}, nil
+}
+
+// referenceValue returns the textual form of a primitive, so that references
+// match across sources regardless of how each source typed them.
+func referenceValue(value typed.Valuable) (string, error) {
+	switch value.Type().Hint().TypeHint() {
+	case typed.String:
+		var s string
+		value.As(&s)
+		return s, nil
+	case typed.Number:
+		var n float64
+		value.As(&n)
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case typed.Boolean:
+		var b bool
+		value.As(&b)
+		return strconv.FormatBool(b), nil
+	default:
+		return "", fmt.Errorf("expected a primitive reference, got %s", value.Type().Name())
+	}
+}
+
+// checkReferences records a finding for every reference missing from targets.
+func checkReferences(findings *[]predicates.Finding, value typed.Valuable, targets map[string]struct{}, message string) (bool, error) {
+	refs, err := referencesOf(value)
+	if err != nil {
+		return false, err
+	}
+
+	findingsCount := len(*findings)
+	for _, ref := range refs {
+		if _, found := targets[ref.value]; found {
+			continue
+		}
+
+		*findings = append(*findings, predicates.Finding{
+			Path:    ref.steps.String(),
+			Message: fmt.Sprintf(message, ref.value),
+		})
+	}
+	return len(*findings) == findingsCount, nil
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package schedule
+
+import (
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type CronIsValidPredicate struct{}
+
+func (cronIsValidPrd *CronIsValidPredicate) Test(value typed.Valuable, _ typed.Valuable) (bool, error) {
+	if _, err := cronScheduleFromValue(value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (cronIsValidPrd *CronIsValidPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Value is a valid cron expression predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Cron expression with 5 or 6 fields, or a macro such as @daily",
+			},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package schedule
+
+import (
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type CronNextRunWithinPredicate struct{}
+
+func (cronNextRunWithinPrd *CronNextRunWithinPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	schedule, err := cronScheduleFromValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	duration, err := durationFromArgs(args)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	nextRun := schedule.next(now)
+	return !nextRun.IsZero() && !nextRun.After(now.Add(duration)), nil
+}
+
+func (cronNextRunWithinPrd *CronNextRunWithinPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Cron expression next run is within a given duration from now predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Cron expression with 5 or 6 fields, or a macro such as @daily",
+			},
+			"Arguments": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Duration such as 1h, 1d or 2w",
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package schedule
+
+import (
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+)
+
+type CronRunsAtMostEveryPredicate struct{}
+
+// Test checks that consecutive runs are never closer than the given interval in wall-clock time.
+func (cronRunsAtMostEveryPrd *CronRunsAtMostEveryPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	schedule, err := cronScheduleFromValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	interval, err := durationFromArgs(args)
+	if err != nil {
+		return false, err
+	}
+
+	minInterval, runs := schedule.minInterval()
+	return !runs || minInterval >= interval, nil
+}
+
+func (cronRunsAtMostEveryPrd *CronRunsAtMostEveryPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Cron expression doesn't run more often than a given interval predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Cron expression with 5 or 6 fields, or a macro such as @daily",
+			},
+			"Arguments": &attributes.StringAttribute{
+				Required:    true,
+				Description: "Minimum interval between runs such as 5m or 1h",
+			},
+		},
+	}
+}
@@ -0,0 +1,391 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package schedule
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+)
+
+type cronField struct {
+	name  string
+	min   uint
+	max   uint
+	names map[string]uint
+}
+
+var (
+	secondField = cronField{name: "second", min: 0, max: 59}
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+const searchYearsLimit = 5
+
+// gregorianCycleDays is the length of the 400-year cycle after which both the
+// calendar and the days of the week repeat.
+const gregorianCycleDays = 146097
+
+type cronSchedule struct {
+	second   uint64
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	domStar  bool
+	dowStar  bool
+	location *time.Location
+}
+
+// parseCron parses standard 5-field expressions, 6-field expressions with a leading
+// seconds field, and @-macros, optionally prefixed with CRON_TZ= or TZ=.
+func parseCron(expr string) (*cronSchedule, error) {
+	spec := strings.TrimSpace(expr)
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("cron expression cannot be empty")
+	}
+
+	location := time.Local
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		tzSpec, rest, _ := strings.Cut(spec, " ")
+		_, tzName, _ := strings.Cut(tzSpec, "=")
+
+		var err error
+		if location, err = time.LoadLocation(tzName); err != nil {
+			return nil, fmt.Errorf("invalid time zone '%s': %w", tzName, err)
+		}
+		spec = strings.TrimSpace(rest)
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		macroSpec, ok := cronMacros[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported cron macro '%s'", spec)
+		}
+		spec = macroSpec
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("expected 5 or 6 fields in cron expression, got %d", len(fields))
+	}
+
+	schedule := &cronSchedule{location: location}
+	var err error
+	if schedule.second, err = parseCronField(fields[0], secondField); err != nil {
+		return nil, err
+	}
+
+	if schedule.minute, err = parseCronField(fields[1], minuteField); err != nil {
+		return nil, err
+	}
+
+	if schedule.hour, err = parseCronField(fields[2], hourField); err != nil {
+		return nil, err
+	}
+
+	if schedule.dom, err = parseCronField(fields[3], domField); err != nil {
+		return nil, err
+	}
+
+	if schedule.month, err = parseCronField(fields[4], monthField); err != nil {
+		return nil, err
+	}
+
+	if schedule.dow, err = parseCronField(fields[5], dowField); err != nil {
+		return nil, err
+	}
+
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1
+	}
+
+	schedule.domStar = fields[3] == "*" || fields[3] == "?"
+	schedule.dowStar = fields[5] == "*" || fields[5] == "?"
+	return schedule, nil
+}
+
+func parseCronField(field string, spec cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		partBits, err := parseCronFieldPart(part, spec)
+		if err != nil {
+			return 0, err
+		}
+		bits |= partBits
+	}
+	return bits, nil
+}
+
+func parseCronFieldPart(part string, spec cronField) (uint64, error) {
+	rangeSpec, stepSpec, hasStep := strings.Cut(part, "/")
+
+	var start, end uint
+	switch {
+	case rangeSpec == "*" || rangeSpec == "?":
+		start, end = spec.min, spec.max
+	case strings.Contains(rangeSpec, "-"):
+		lowSpec, highSpec, _ := strings.Cut(rangeSpec, "-")
+
+		var err error
+		if start, err = parseCronValue(lowSpec, spec); err != nil {
+			return 0, err
+		}
+
+		if end, err = parseCronValue(highSpec, spec); err != nil {
+			return 0, err
+		}
+	default:
+		value, err := parseCronValue(rangeSpec, spec)
+		if err != nil {
+			return 0, err
+		}
+		start, end = value, value
+		if hasStep {
+			end = spec.max
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("invalid %s range '%s'", spec.name, part)
+	}
+
+	step := uint(1)
+	if hasStep {
+		stepVal, err := strconv.ParseUint(stepSpec, 10, 8)
+		if err != nil || stepVal == 0 {
+			return 0, fmt.Errorf("invalid %s step '%s'", spec.name, stepSpec)
+		}
+		step = uint(stepVal)
+	}
+
+	var bits uint64
+	for value := start; value <= end; value += step {
+		bits |= 1 << value
+	}
+	return bits, nil
+}
+
+func parseCronValue(valueSpec string, spec cronField) (uint, error) {
+	if value, ok := spec.names[strings.ToLower(valueSpec)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.ParseUint(valueSpec, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value '%s'", spec.name, valueSpec)
+	}
+
+	if uint(value) < spec.min || uint(value) > spec.max {
+		return 0, fmt.Errorf("%s value %d out of range [%d, %d]", spec.name, value, spec.min, spec.max)
+	}
+	return uint(value), nil
+}
+
+func (schedule *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := schedule.dom&(1<<uint(t.Day())) != 0
+	dowMatch := schedule.dow&(1<<uint(t.Weekday())) != 0
+	if schedule.domStar || schedule.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the first activation time after t, or the zero time if the
+// schedule doesn't activate within the search limit.
+func (schedule *cronSchedule) next(t time.Time) time.Time {
+	origLocation := t.Location()
+	t = t.In(schedule.location)
+	t = t.Add(time.Second - time.Duration(t.Nanosecond())*time.Nanosecond)
+
+	yearLimit := t.Year() + searchYearsLimit
+	added := false
+
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for schedule.month&(1<<uint(t.Month())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, schedule.location)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !schedule.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, schedule.location)
+		}
+		t = t.AddDate(0, 0, 1)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for schedule.hour&(1<<uint(t.Hour())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, schedule.location)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for schedule.minute&(1<<uint(t.Minute())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	for schedule.second&(1<<uint(t.Second())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Second)
+		}
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto wrap
+		}
+	}
+	return t.In(origLocation)
+}
+
+// minInterval returns the shortest wall-clock gap between consecutive runs, or false
+// if the schedule never runs. Days are walked in UTC over a full calendar cycle, so the
+// result doesn't depend on the current time or on daylight saving transitions.
+func (schedule *cronSchedule) minInterval() (time.Duration, bool) {
+	var dayTimes []time.Duration
+	for hour := hourField.min; hour <= hourField.max; hour++ {
+		if schedule.hour&(1<<hour) == 0 {
+			continue
+		}
+
+		for minute := minuteField.min; minute <= minuteField.max; minute++ {
+			if schedule.minute&(1<<minute) == 0 {
+				continue
+			}
+
+			for second := secondField.min; second <= secondField.max; second++ {
+				if schedule.second&(1<<second) != 0 {
+					dayTime := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+					dayTimes = append(dayTimes, dayTime)
+				}
+			}
+		}
+	}
+
+	if len(dayTimes) == 0 {
+		return 0, false
+	}
+
+	minGap := time.Duration(math.MaxInt64)
+	for i := 1; i < len(dayTimes); i++ {
+		minGap = min(minGap, dayTimes[i]-dayTimes[i-1])
+	}
+
+	firstDay, prevDay, minDayGap := -1, -1, math.MaxInt
+	day := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for dayIdx := 0; dayIdx < gregorianCycleDays; dayIdx++ {
+		if schedule.month&(1<<uint(day.Month())) != 0 && schedule.dayMatches(day) {
+			if firstDay < 0 {
+				firstDay = dayIdx
+			} else {
+				minDayGap = min(minDayGap, dayIdx-prevDay)
+			}
+			prevDay = dayIdx
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	if firstDay < 0 {
+		return 0, false
+	}
+
+	minDayGap = min(minDayGap, firstDay+gregorianCycleDays-prevDay)
+	daySpan := dayTimes[len(dayTimes)-1] - dayTimes[0]
+	return min(minGap, time.Duration(minDayGap)*util.Day-daySpan), true
+}
+
+func cronScheduleFromValue(value typed.Valuable) (*cronSchedule, error) {
+	if value == nil {
+		return nil, fmt.Errorf("value is nil")
+	}
+
+	if value.Type().Hint().TypeHint() != typed.String {
+		return nil, fmt.Errorf("expected a string value, got %s", value.Type().Name())
+	}
+
+	var expr string
+	value.As(&expr)
+	return parseCron(expr)
+}
+
+func durationFromArgs(args typed.Valuable) (time.Duration, error) {
+	if args == nil {
+		return 0, fmt.Errorf("argument is nil")
+	}
+
+	if args.Type().Hint().TypeHint() != typed.String {
+		return 0, fmt.Errorf("expected a duration argument, got %s", args.Type().Name())
+	}
+
+	var durationVal string
+	args.As(&durationVal)
+
+	duration, err := util.ParseDuration(durationVal)
+	if err != nil {
+		return 0, err
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("expected a positive duration, got %s", durationVal)
+	}
+	return duration, nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package secret
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/conformize/conformize/common/ds"
+	"github.com/conformize/conformize/common/path"
+	"github.com/conformize/conformize/common/reflected"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/internal/providers/api/schema/attributes"
+	"github.com/conformize/conformize/predicates"
+)
+
+type NoPlaintextSecretsPredicate struct {
+	findings []predicates.Finding
+}
+
+func (noSecretsPrd *NoPlaintextSecretsPredicate) Test(value typed.Valuable, args typed.Valuable) (bool, error) {
+	if value == nil {
+		return false, fmt.Errorf("value is nil")
+	}
+
+	allowlist, err := parseAllowlist(args)
+	if err != nil {
+		return false, err
+	}
+
+	findingsCount := len(noSecretsPrd.findings)
+	noSecretsPrd.scanValue(path.Steps{}, value, allowlist)
+	return len(noSecretsPrd.findings) == findingsCount, nil
+}
+
+func (noSecretsPrd *NoPlaintextSecretsPredicate) TestNode(node *ds.Node[string, any], args typed.Valuable) (bool, error) {
+	if node == nil {
+		return false, fmt.Errorf("value is nil")
+	}
+
+	allowlist, err := parseAllowlist(args)
+	if err != nil {
+		return false, err
+	}
+
+	findingsCount := len(noSecretsPrd.findings)
+	if err := noSecretsPrd.scanNode(path.Steps{}, node, allowlist); err != nil {
+		return false, err
+	}
+	return len(noSecretsPrd.findings) == findingsCount, nil
+}
+
+func (noSecretsPrd *NoPlaintextSecretsPredicate) Findings() []predicates.Finding {
+	return noSecretsPrd.findings
+}
+
+func (noSecretsPrd *NoPlaintextSecretsPredicate) scanNode(steps path.Steps, node *ds.Node[string, any], allowlist []*regexp.Regexp) error {
+	if node.Value != nil {
+		reflectVal := reflect.ValueOf(node.Value)
+		val, err := reflected.ValueFromTypeHint(reflectVal, typed.TypeHintOf(reflectVal))
+		if err != nil {
+			return fmt.Errorf("couldn't read value at '%s': %w", steps.String(), err)
+		}
+		noSecretsPrd.scanValue(steps, val, allowlist)
+		return nil
+	}
+
+	children := node.Children()
+	keys := make([]string, 0, len(children))
+	for key := range children {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		childList := children[key]
+		for idx, child := range childList {
+			childSteps := steps.Clone()
+			childSteps.Add(path.KeyStep(key))
+			if childList.Count() > 1 {
+				childSteps.Add(path.IndexStep(strconv.Itoa(idx)))
+			}
+
+			if err := noSecretsPrd.scanNode(childSteps, child, allowlist); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (noSecretsPrd *NoPlaintextSecretsPredicate) scanValue(steps path.Steps, value typed.Valuable, allowlist []*regexp.Regexp) {
+	switch val := value.(type) {
+	case *typed.StringValue:
+		var s string
+		val.As(&s)
+
+		kind, found := detectSecret(s)
+		if !found || isAllowed(allowlist, steps.String(), s) {
+			return
+		}
+		noSecretsPrd.findings = append(noSecretsPrd.findings, predicates.Finding{
+			Path:    steps.String(),
+			Message: fmt.Sprintf("possible %s found", kind),
+		})
+	case *typed.MapValue:
+		noSecretsPrd.scanFields(steps, val.Elements, allowlist)
+	case *typed.ObjectValue:
+		noSecretsPrd.scanFields(steps, val.Fields, allowlist)
+	case *typed.VariantValue:
+		if val.Value != nil {
+			noSecretsPrd.scanValue(steps, val.Value, allowlist)
+		}
+	case typed.Elementable:
+		for idx, elem := range val.Items() {
+			elemSteps := steps.Clone()
+			elemSteps.Add(path.IndexStep(strconv.Itoa(idx)))
+			noSecretsPrd.scanValue(elemSteps, elem, allowlist)
+		}
+	}
+}
+
+func (noSecretsPrd *NoPlaintextSecretsPredicate) scanFields(steps path.Steps, fields map[string]typed.Valuable, allowlist []*regexp.Regexp) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		fieldSteps := steps.Clone()
+		fieldSteps.Add(path.KeyStep(key))
+		noSecretsPrd.scanValue(fieldSteps, fields[key], allowlist)
+	}
+}
+
+func parseAllowlist(args typed.Valuable) ([]*regexp.Regexp, error) {
+	if args == nil {
+		return nil, nil
+	}
+
+	var patterns []string
+	switch arg := args.(type) {
+	case *typed.StringValue:
+		var pattern string
+		arg.As(&pattern)
+		patterns = append(patterns, pattern)
+	case typed.Elementable:
+		for _, elem := range arg.Items() {
+			if elem.Type().Hint().TypeHint() != typed.String {
+				return nil, fmt.Errorf("expected allowlist entries to be strings, got %s", elem.Type().Name())
+			}
+
+			var pattern string
+			elem.As(&pattern)
+			patterns = append(patterns, pattern)
+		}
+	default:
+		return nil, fmt.Errorf("expected a list of allowlist patterns as arguments, got %s", args.Type().Name())
+	}
+
+	allowlist := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		allowed, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowlist pattern '%s': %w", pattern, err)
+		}
+		allowlist = append(allowlist, allowed)
+	}
+	return allowlist, nil
+}
+
+func (noSecretsPrd *NoPlaintextSecretsPredicate) Schema() schema.Schemable {
+	return &schema.Schema{
+		Description: "Value contains no plaintext secrets or high entropy strings predicate",
+		Version:     1,
+		Attributes: map[string]schema.Attributeable{
+			"Value": &attributes.VariantAttribute{
+				Required: true,
+				VariantsTypes: []typed.Typeable{
+					&typed.StringTyped{},
+					&typed.ListTyped{ElementsType: &typed.GenericTyped{}},
+					&typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+				},
+			},
+			"Arguments": &attributes.ListAttribute{
+				Description:  "Regular expressions matching values or paths excluded from detection",
+				ElementsType: &typed.StringTyped{},
+			},
+		},
+	}
+}
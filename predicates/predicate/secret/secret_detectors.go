@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package secret
+
+import (
+	"math"
+	"regexp"
+	"strings"
+)
+
+const (
+	minEntropyCandidateLength = 20
+	minEntropyBitsPerChar     = 4.0
+)
+
+type secretDetector struct {
+	kind    string
+	pattern *regexp.Regexp
+	accepts func(match []string) bool
+}
+
+var envVarPlaceholderExp = regexp.MustCompile(`^\$\{?[A-Za-z_][A-Za-z0-9_]*\}?$`)
+
+func isNotPlaceholder(match []string) bool {
+	return len(match) < 2 || !envVarPlaceholderExp.MatchString(match[1])
+}
+
+var secretDetectors = []secretDetector{
+	{
+		kind:    "AWS access key ID",
+		pattern: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA|AGPA|AIDA|AIPA|ANPA|ANVA|AROA|APKA)[0-9A-Z]{16}\b`),
+	},
+	{
+		kind:    "private key",
+		pattern: regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY(?: BLOCK)?-----`),
+	},
+	{
+		kind:    "JSON Web Token",
+		pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{4,}\.[A-Za-z0-9_-]{4,}\.[A-Za-z0-9_-]{4,}`),
+	},
+	{
+		kind:    "GitHub token",
+		pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`),
+	},
+	{
+		kind:    "connection string with password",
+		pattern: regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^:/?#\s@]+:([^@/?#\s]+)@`),
+		accepts: isNotPlaceholder,
+	},
+	{
+		kind:    "connection string with password",
+		pattern: regexp.MustCompile(`(?i)(?:^|;)\s*(?:password|pwd)\s*=\s*([^;]+)`),
+		accepts: isNotPlaceholder,
+	},
+}
+
+var entropyCandidateExp = regexp.MustCompile(`^[A-Za-z0-9+/=_\-]+$`)
+
+func detectSecret(s string) (string, bool) {
+	for _, detector := range secretDetectors {
+		match := detector.pattern.FindStringSubmatch(s)
+		if match == nil {
+			continue
+		}
+
+		if detector.accepts == nil || detector.accepts(match) {
+			return detector.kind, true
+		}
+	}
+
+	if len(s) >= minEntropyCandidateLength && entropyCandidateExp.MatchString(s) &&
+		shannonEntropy(s) >= minEntropyBitsPerChar {
+		return "high entropy string", true
+	}
+	return "", false
+}
+
+func shannonEntropy(s string) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+
+	frequencies := make(map[rune]int)
+	length := 0
+	for _, r := range s {
+		frequencies[r]++
+		length++
+	}
+
+	var entropy float64
+	for _, count := range frequencies {
+		p := float64(count) / float64(length)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+func isAllowed(allowlist []*regexp.Regexp, findingPath string, s string) bool {
+	for _, allowed := range allowlist {
+		if allowed.MatchString(s) || allowed.MatchString(strings.ReplaceAll(findingPath, "'", "")) {
+			return true
+		}
+	}
+	return false
+}
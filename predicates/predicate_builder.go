@@ -13,4 +13,5 @@ import (
 
 type PredicateBuilder interface {
 	Build(condition condition.ConditionType) (Predicate, error)
+	BuildByName(name string) (Predicate, error)
 }
@@ -10,10 +10,15 @@ package predicatefactory
 import (
 	"github.com/conformize/conformize/predicates"
 	"github.com/conformize/conformize/predicates/condition"
+	"github.com/conformize/conformize/predicates/predicate/certificate"
 	"github.com/conformize/conformize/predicates/predicate/collection"
 	"github.com/conformize/conformize/predicates/predicate/date"
 	"github.com/conformize/conformize/predicates/predicate/equality"
+	"github.com/conformize/conformize/predicates/predicate/policy"
 	"github.com/conformize/conformize/predicates/predicate/primitive"
+	"github.com/conformize/conformize/predicates/predicate/reference"
+	"github.com/conformize/conformize/predicates/predicate/schedule"
+	"github.com/conformize/conformize/predicates/predicate/secret"
 )
 
 type PredicateBuilderFunc func(prdBuilder predicates.PredicateBuilder) predicates.Predicate
@@ -44,6 +49,24 @@ var predicateBuilders = &PredicateBuilderFuncsMap{
 	condition.RANGE: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
 		return &primitive.NumberIsWithinRangePredicate[float64]{}
 	},
+	condition.IS_INTEGER: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &primitive.NumberIsIntegerPredicate[float64]{}
+	},
+	condition.MULTIPLE_OF: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &primitive.NumberIsMultipleOfPredicate[float64]{}
+	},
+	condition.MAX_DECIMAL_PLACES: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &primitive.NumberMaxDecimalPlacesPredicate[float64]{}
+	},
+	condition.IS_PERCENTAGE: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &primitive.NumberIsPercentagePredicate[float64]{}
+	},
+	condition.IS_POWER_OF_TWO: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &primitive.NumberIsPowerOfTwoPredicate[float64]{}
+	},
+	condition.APPROX_EQUAL: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &primitive.NumberIsApproxEqualPredicate[float64]{}
+	},
 	condition.TRUE: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
 		return &primitive.BooleanIsTruePredicate{}
 	},
@@ -65,6 +88,54 @@ var predicateBuilders = &PredicateBuilderFuncsMap{
 	condition.SUBSET_OF: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
 		return &collection.IsSubsetPredicate{}
 	},
+	condition.NOT_EMPTY: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.IsNotEmptyPredicate{}
+	},
+	condition.LACKS: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.LacksElementsPredicate{}
+	},
+	condition.LENGTH_BETWEEN: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.LengthBetweenPredicate{}
+	},
+	condition.IS_SORTED: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.IsSortedPredicate{}
+	},
+	condition.ALL_EQUAL: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.AllEqualPredicate{}
+	},
+	condition.INTERSECTS: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.IntersectsPredicate{}
+	},
+	condition.DISJOINT_FROM: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.DisjointFromPredicate{}
+	},
+	condition.COUNT_OF: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.CountOfPredicate{}
+	},
+	condition.UNIQUE_BY: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.UniqueByPredicate{}
+	},
+	condition.CONTAINS_OBJECT_MATCHING: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.ContainsObjectMatchingPredicate{}
+	},
+	condition.HAS_KEYS: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.HasKeysPredicate{}
+	},
+	condition.KEYS_MATCH: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.KeysMatchPredicate{}
+	},
+	condition.NO_EXTRA_KEYS: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &collection.NoExtraKeysPredicate{}
+	},
+	condition.EXISTS_IN: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &reference.ExistsInPredicate{}
+	},
+	condition.KEY_OF: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &reference.KeyOfPredicate{}
+	},
+	condition.REGO: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &policy.RegoPredicate{}
+	},
 	condition.SAME: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
 		return &date.DateIsPredicate{}
 	},
@@ -92,4 +163,34 @@ var predicateBuilders = &PredicateBuilderFuncsMap{
 	condition.FUTURE: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
 		return &date.DateIsInFuture{}
 	},
+	condition.NO_PLAINTEXT_SECRETS: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &secret.NoPlaintextSecretsPredicate{}
+	},
+	condition.CERT_VALID: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &certificate.CertIsValidPredicate{}
+	},
+	condition.CERT_EXPIRES_AFTER: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &certificate.CertExpiresAfterPredicate{}
+	},
+	condition.CERT_HAS_SAN: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &certificate.CertHasSANPredicate{}
+	},
+	condition.CERT_ISSUED_BY: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &certificate.CertIssuedByPredicate{}
+	},
+	condition.KEY_MATCHES_CERT: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &certificate.KeyMatchesCertPredicate{}
+	},
+	condition.MIN_KEY_SIZE: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &certificate.MinKeySizePredicate{}
+	},
+	condition.CRON_VALID: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &schedule.CronIsValidPredicate{}
+	},
+	condition.CRON_RUNS_AT_MOST_EVERY: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &schedule.CronRunsAtMostEveryPredicate{}
+	},
+	condition.CRON_NEXT_RUN_WITHIN: func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return &schedule.CronNextRunWithinPredicate{}
+	},
 }
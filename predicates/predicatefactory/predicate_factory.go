@@ -9,6 +9,7 @@ package predicatefactory
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/conformize/conformize/predicates"
@@ -27,6 +28,56 @@ func (prdFactory *PredicateFactory) Build(condition condition.ConditionType) (pr
 	return nil, fmt.Errorf("predicate for condition %s not found", condition.String())
 }
 
+// BuildByName builds a built-in predicate by its condition name, or a predicate
+// registered at runtime, e.g. by a blueprint plugin.
+func (prdFactory *PredicateFactory) BuildByName(name string) (predicates.Predicate, error) {
+	if prdCondition := condition.FromString(name); prdCondition != condition.UNKNOWN {
+		return prdFactory.Build(prdCondition)
+	}
+
+	namedPredicateBuildersMu.RLock()
+	predicate, ok := namedPredicateBuilders[name]
+	namedPredicateBuildersMu.RUnlock()
+	if ok {
+		return predicate(prdFactory), nil
+	}
+	return nil, fmt.Errorf("predicate '%s' not found", name)
+}
+
+var namedPredicateBuilders = map[string]PredicateBuilderFunc{}
+var namedPredicateBuildersMu sync.RWMutex
+
+// RegisterPredicate makes a predicate available under name to BuildByName. Names of
+// built-in predicates can't be registered, while registering a name again replaces its builder.
+func RegisterPredicate(name string, builder PredicateBuilderFunc) error {
+	if condition.FromString(name) != condition.UNKNOWN {
+		return fmt.Errorf("predicate '%s' is built-in and can't be registered", name)
+	}
+
+	namedPredicateBuildersMu.Lock()
+	defer namedPredicateBuildersMu.Unlock()
+	namedPredicateBuilders[name] = builder
+	return nil
+}
+
+// PredicateNames returns the sorted names of the built-in predicates and of the
+// predicates registered at runtime.
+func PredicateNames() []string {
+	names := make([]string, 0, len(*predicateBuilders))
+	for prdCondition := range *predicateBuilders {
+		names = append(names, prdCondition.Name())
+	}
+
+	namedPredicateBuildersMu.RLock()
+	for name := range namedPredicateBuilders {
+		names = append(names, name)
+	}
+	namedPredicateBuildersMu.RUnlock()
+
+	sort.Strings(names)
+	return names
+}
+
 func newPredicateFactory() predicates.PredicateBuilder {
 	return &PredicateFactory{predicateBuilders}
 }
@@ -9,11 +9,15 @@ package predicatefactory
 
 import (
 	"reflect"
+	"slices"
+	"sort"
 	"testing"
 
 	"github.com/conformize/conformize/common/typed"
 	"github.com/conformize/conformize/predicates"
 	"github.com/conformize/conformize/predicates/condition"
+	"github.com/conformize/conformize/predicates/predicate/certificate"
+	"github.com/conformize/conformize/predicates/predicate/collection"
 	"github.com/conformize/conformize/predicates/predicate/equality"
 	"github.com/conformize/conformize/predicates/predicate/primitive"
 	"github.com/conformize/conformize/predicates/tests"
@@ -118,6 +122,20 @@ func TestPredicateFactory_Build(t *testing.T) {
 			},
 			want: &equality.ValueIsEqualPredicate{PredicateBuilder: Instance()},
 		},
+		{
+			name: "returns IsNotEmptyPredicate for condition notEmpty",
+			args: args{
+				condition: condition.NOT_EMPTY,
+			},
+			want: &collection.IsNotEmptyPredicate{},
+		},
+		{
+			name: "returns LacksElementsPredicate for condition lacks",
+			args: args{
+				condition: condition.LACKS,
+			},
+			want: &collection.LacksElementsPredicate{},
+		},
 	}
 	prdFactory := Instance()
 	for _, tt := range tests {
@@ -154,3 +172,86 @@ func TestInstance(t *testing.T) {
 		})
 	}
 }
+
+func TestPredicateFactory_BuildByName(t *testing.T) {
+	registeredPrd := &primitive.BooleanIsTruePredicate{}
+	if err := RegisterPredicate("customIsTrue", func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return registeredPrd
+	}); err != nil {
+		t.Fatalf("RegisterPredicate() error = %v", err)
+	}
+
+	if err := RegisterPredicate("matches", func(prdBuilder predicates.PredicateBuilder) predicates.Predicate {
+		return registeredPrd
+	}); err == nil {
+		t.Errorf("RegisterPredicate() expected error when registering a built-in predicate name")
+	}
+
+	tests := []struct {
+		name          string
+		predicateName string
+		want          predicates.Predicate
+		wantErr       bool
+	}{
+		{
+			name:          "returns built-in predicate by its condition name",
+			predicateName: "matches",
+			want:          &primitive.StringMatchesExpressionPredicate{},
+		},
+		{
+			name:          "returns registered predicate by its name",
+			predicateName: "customIsTrue",
+			want:          registeredPrd,
+		},
+		{
+			name:          "returns built-in predicate by its alias",
+			predicateName: "certHasSAN",
+			want:          &certificate.CertHasSANPredicate{},
+		},
+		{
+			name:          "returns built-in predicate by its camel case condition name",
+			predicateName: "certHasSan",
+			want:          &certificate.CertHasSANPredicate{},
+		},
+		{
+			name:          "returns error for condition name with different case",
+			predicateName: "MATCHES",
+			wantErr:       true,
+		},
+		{
+			name:          "returns error for unknown predicate name",
+			predicateName: "unknownPredicate",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Instance().BuildByName(tt.predicateName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PredicateFactory.BuildByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PredicateFactory.BuildByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredicateNames(t *testing.T) {
+	names := PredicateNames()
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("PredicateNames() = %v, want sorted names", names)
+	}
+
+	for _, name := range names {
+		if _, err := Instance().BuildByName(name); err != nil {
+			t.Errorf("PredicateNames() returned name '%s' which can't be built: %v", name, err)
+		}
+	}
+
+	if !slices.Contains(names, "matches") {
+		t.Errorf("PredicateNames() = %v, want it to contain 'matches'", names)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/certificate"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+type certFixture struct {
+	caPEM   string
+	certPEM string
+	keyPEM  string
+}
+
+func newCertFixture(t *testing.T, notAfter time.Time) *certFixture {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Conformize Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, _ := x509.ParseCertificate(caDER)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "api.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"api.example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &certFixture{
+		caPEM:   string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})),
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+func strVal(s string) typed.Valuable {
+	return tests.PrimVal(s, &typed.StringTyped{})
+}
+
+func TestCertificatePredicates(t *testing.T) {
+	fixture := newCertFixture(t, time.Now().Add(10*24*time.Hour))
+	otherFixture := newCertFixture(t, time.Now().Add(-time.Minute))
+
+	workDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(workDir, "server.pem"), []byte(fixture.certPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(workDir, "ca.pem"), []byte(fixture.caPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(workDir, "ca-bundle"), []byte("no certificates"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cwd := util.GetWorkDir()
+	util.SetWorkDir(workDir)
+	defer util.SetWorkDir(cwd)
+
+	tests := []struct {
+		name      string
+		predicate predicates.Predicate
+		value     typed.Valuable
+		args      typed.Valuable
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "certValid returns true for a certificate within its validity period",
+			predicate: &certificate.CertIsValidPredicate{},
+			value:     strVal(fixture.certPEM),
+			want:      true,
+		},
+		{
+			name:      "certValid returns false for an expired certificate",
+			predicate: &certificate.CertIsValidPredicate{},
+			value:     strVal(otherFixture.certPEM),
+			want:      false,
+		},
+		{
+			name:      "certValid reads a certificate file relative to the working directory",
+			predicate: &certificate.CertIsValidPredicate{},
+			value:     strVal("server.pem"),
+			want:      true,
+		},
+		{
+			name:      "certValid returns error when value is not a certificate",
+			predicate: &certificate.CertIsValidPredicate{},
+			value:     strVal("-----BEGIN CERTIFICATE-----\ngarbage\n-----END CERTIFICATE-----"),
+			wantErr:   true,
+		},
+		{
+			name:      "certExpiresAfter returns true when certificate outlives the duration",
+			predicate: &certificate.CertExpiresAfterPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("7d"),
+			want:      true,
+		},
+		{
+			name:      "certExpiresAfter returns false when certificate expires within the duration",
+			predicate: &certificate.CertExpiresAfterPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("30d"),
+			want:      false,
+		},
+		{
+			name:      "certHasSan returns true when all names are present",
+			predicate: &certificate.CertHasSANPredicate{},
+			value:     strVal(fixture.certPEM),
+			args: typed.NewListValue([]typed.Valuable{
+				strVal("API.example.com"),
+				strVal("10.0.0.1"),
+			}, &typed.StringTyped{}),
+			want: true,
+		},
+		{
+			name:      "certHasSan returns false when a name is missing",
+			predicate: &certificate.CertHasSANPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("admin.example.com"),
+			want:      false,
+		},
+		{
+			name:      "certIssuedBy returns true for issuer common name",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("Conformize Test CA"),
+			want:      true,
+		},
+		{
+			name:      "certIssuedBy returns true when signed by CA certificate",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal(fixture.caPEM),
+			want:      true,
+		},
+		{
+			name:      "certIssuedBy returns false when signed by another CA",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal(otherFixture.caPEM),
+			want:      false,
+		},
+		{
+			name:      "certIssuedBy reads a CA certificate file relative to the working directory",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("ca.pem"),
+			want:      true,
+		},
+		{
+			name:      "certIssuedBy returns false for another issuer name",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("Other CA"),
+			want:      false,
+		},
+		{
+			name:      "certIssuedBy returns error when CA certificate file is missing",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("missing-ca.crt"),
+			wantErr:   true,
+		},
+		{
+			name:      "certIssuedBy returns error when CA certificate path is missing",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("certs/ca"),
+			wantErr:   true,
+		},
+		{
+			name:      "certIssuedBy returns error when CA certificate file holds no certificates",
+			predicate: &certificate.CertIssuedByPredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      strVal("ca-bundle"),
+			wantErr:   true,
+		},
+		{
+			name:      "keyMatchesCert returns true for matching key pair",
+			predicate: &certificate.KeyMatchesCertPredicate{},
+			value:     strVal(fixture.keyPEM),
+			args:      strVal(fixture.certPEM),
+			want:      true,
+		},
+		{
+			name:      "keyMatchesCert returns false for mismatching key pair",
+			predicate: &certificate.KeyMatchesCertPredicate{},
+			value:     strVal(otherFixture.keyPEM),
+			args:      strVal(fixture.certPEM),
+			want:      false,
+		},
+		{
+			name:      "minKeySize returns true when key size is sufficient",
+			predicate: &certificate.MinKeySizePredicate{},
+			value:     strVal(fixture.certPEM),
+			args:      tests.PrimVal(256, &typed.NumberTyped{}),
+			want:      true,
+		},
+		{
+			name:      "minKeySize returns false when key is too small",
+			predicate: &certificate.MinKeySizePredicate{},
+			value:     strVal(fixture.keyPEM),
+			args:      tests.PrimVal(384, &typed.NumberTyped{}),
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.predicate.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%T.Test() error = %v, wantErr %v", tt.predicate, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("%T.Test() = %v, want %v", tt.predicate, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates/predicate/collection"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func strList(values ...string) typed.Valuable {
+	elements := make([]typed.Valuable, len(values))
+	for idx, v := range values {
+		elements[idx] = tests.PrimVal(v, &typed.StringTyped{})
+	}
+	return typed.NewListValue(elements, &typed.StringTyped{})
+}
+
+func priorityObject(name string, priority int) typed.Valuable {
+	return typed.NewObjectValue(
+		map[string]typed.Valuable{
+			"name":     tests.PrimVal(name, &typed.StringTyped{}),
+			"priority": tests.PrimVal(priority, &typed.NumberTyped{}),
+		},
+		map[string]typed.Typeable{"name": &typed.StringTyped{}, "priority": &typed.NumberTyped{}},
+	)
+}
+
+func TestCollectionIsSortedPredicate(t *testing.T) {
+	rules := typed.NewListValue(
+		[]typed.Valuable{priorityObject("deny", 30), priorityObject("audit", 20), priorityObject("allow", 10)},
+		&typed.ObjectTyped{},
+	)
+
+	tests := []struct {
+		name    string
+		value   typed.Valuable
+		args    typed.Valuable
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "returns true when list is sorted in ascending order by default",
+			value: strList("alpha", "beta", "gamma"),
+			want:  true,
+		},
+		{
+			name:  "returns false when list is not sorted in ascending order",
+			value: strList("beta", "alpha", "gamma"),
+			args:  tests.PrimVal("asc", &typed.StringTyped{}),
+			want:  false,
+		},
+		{
+			name:  "returns true when list is sorted in descending order",
+			value: strList("gamma", "beta", "beta", "alpha"),
+			args:  tests.PrimVal("desc", &typed.StringTyped{}),
+			want:  true,
+		},
+		{
+			name:  "returns true when objects are sorted by key in descending order",
+			value: rules,
+			args: typed.NewMapValue(
+				map[string]typed.Valuable{
+					"by":    tests.PrimVal("priority", &typed.StringTyped{}),
+					"order": tests.PrimVal("desc", &typed.StringTyped{}),
+				}, &typed.StringTyped{},
+			),
+			want: true,
+		},
+		{
+			name:  "returns false when objects are not sorted by key in ascending order",
+			value: rules,
+			args: typed.NewMapValue(
+				map[string]typed.Valuable{"by": tests.PrimVal("name", &typed.StringTyped{})},
+				&typed.StringTyped{},
+			),
+			want: false,
+		},
+		{
+			name:  "returns false and error when sort key is missing",
+			value: rules,
+			args: typed.NewMapValue(
+				map[string]typed.Valuable{"by": tests.PrimVal("weight", &typed.StringTyped{})},
+				&typed.StringTyped{},
+			),
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name:    "returns false and error when sort order is unknown",
+			value:   strList("a", "b"),
+			args:    tests.PrimVal("sideways", &typed.StringTyped{}),
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isSortedPrd := &collection.IsSortedPredicate{}
+			got, err := isSortedPrd.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("collection.IsSortedPredicate.Test() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("collection.IsSortedPredicate.Test() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates/predicate/collection"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func TestCollectionLacksElementsPredicate(t *testing.T) {
+	list := typed.NewListValue(
+		[]typed.Valuable{
+			tests.PrimVal("read", &typed.StringTyped{}),
+			tests.PrimVal("write", &typed.StringTyped{}),
+		}, &typed.StringTyped{},
+	)
+
+	tests := []struct {
+		name    string
+		value   typed.Valuable
+		args    typed.Valuable
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "returns true when none of elements are found in list",
+			value: list,
+			args: typed.NewListValue(
+				[]typed.Valuable{tests.PrimVal("admin", &typed.StringTyped{}), tests.PrimVal("delete", &typed.StringTyped{})},
+				&typed.StringTyped{},
+			),
+			want: true,
+		},
+		{
+			name:  "returns false when any of elements are found in list",
+			value: list,
+			args: typed.NewListValue(
+				[]typed.Valuable{tests.PrimVal("admin", &typed.StringTyped{}), tests.PrimVal("write", &typed.StringTyped{})},
+				&typed.StringTyped{},
+			),
+			want: false,
+		},
+		{
+			name:  "returns false when single element is found in list",
+			value: list,
+			args:  tests.PrimVal("read", &typed.StringTyped{}),
+			want:  false,
+		},
+		{
+			name: "returns true when single element is not found in map",
+			value: typed.NewMapValue(
+				map[string]typed.Valuable{"level": tests.PrimVal("info", &typed.StringTyped{})},
+				&typed.StringTyped{},
+			),
+			args: tests.PrimVal("debug", &typed.StringTyped{}),
+			want: true,
+		},
+		{
+			name:    "returns false and error when value is not a collection",
+			value:   tests.PrimVal("read", &typed.StringTyped{}),
+			args:    tests.PrimVal("read", &typed.StringTyped{}),
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lacksPrd := &collection.LacksElementsPredicate{}
+			got, err := lacksPrd.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("collection.LacksElementsPredicate.Test() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("collection.LacksElementsPredicate.Test() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
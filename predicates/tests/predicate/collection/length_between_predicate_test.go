@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates/predicate/collection"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func bounds(lower, upper float64) typed.Valuable {
+	return typed.NewListValue(
+		[]typed.Valuable{tests.PrimVal(lower, &typed.NumberTyped{}), tests.PrimVal(upper, &typed.NumberTyped{})},
+		&typed.NumberTyped{},
+	)
+}
+
+func TestCollectionLengthBetweenPredicate(t *testing.T) {
+	list := typed.NewListValue(
+		[]typed.Valuable{
+			tests.PrimVal(1, &typed.NumberTyped{}),
+			tests.PrimVal(2, &typed.NumberTyped{}),
+			tests.PrimVal(3, &typed.NumberTyped{}),
+		}, &typed.NumberTyped{},
+	)
+
+	tests := []struct {
+		name    string
+		value   typed.Valuable
+		args    typed.Valuable
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "returns true when list length is within bounds",
+			value: list,
+			args:  bounds(1, 3),
+			want:  true,
+		},
+		{
+			name:  "returns false when list length is above upper bound",
+			value: list,
+			args:  bounds(0, 2),
+			want:  false,
+		},
+		{
+			name: "returns true when map length is within bounds",
+			value: typed.NewMapValue(
+				map[string]typed.Valuable{"a": tests.PrimVal(1, &typed.NumberTyped{})},
+				&typed.NumberTyped{},
+			),
+			args: bounds(1, 1),
+			want: true,
+		},
+		{
+			name:    "returns false and error when lower bound is greater than upper bound",
+			value:   list,
+			args:    bounds(3, 1),
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name:    "returns false and error when bounds are missing",
+			value:   list,
+			args:    tests.PrimVal(3, &typed.NumberTyped{}),
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lengthBetweenPrd := &collection.LengthBetweenPredicate{}
+			got, err := lengthBetweenPrd.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("collection.LengthBetweenPredicate.Test() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("collection.LengthBetweenPredicate.Test() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/collection"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func mapVal(fields map[string]any) typed.Valuable {
+	elements := make(map[string]typed.Valuable, len(fields))
+	for key, field := range fields {
+		switch v := field.(type) {
+		case string:
+			elements[key] = tests.PrimVal(v, &typed.StringTyped{})
+		case int:
+			elements[key] = tests.PrimVal(v, &typed.NumberTyped{})
+		case bool:
+			elements[key] = tests.PrimVal(v, &typed.BooleanTyped{})
+		case map[string]any:
+			elements[key] = mapVal(v)
+		}
+	}
+	return typed.NewMapValue(elements, &typed.GenericTyped{})
+}
+
+func TestCollectionObjectPredicates(t *testing.T) {
+	upstreams := typed.NewListValue(
+		[]typed.Valuable{
+			mapVal(map[string]any{"host": "a.internal", "port": 8080, "tls": map[string]any{"enabled": true, "version": "1.3"}}),
+			mapVal(map[string]any{"host": "b.internal", "port": 8080}),
+			mapVal(map[string]any{"host": "a.internal", "port": 8081}),
+		}, &typed.MapTyped{ElementsType: &typed.GenericTyped{}},
+	)
+	server := mapVal(map[string]any{"listen": "0.0.0.0", "port": 443, "debug": true})
+
+	tests := []struct {
+		name      string
+		predicate predicates.Predicate
+		value     typed.Valuable
+		args      typed.Valuable
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "uniqueBy returns true when objects are unique by all keys",
+			predicate: &collection.UniqueByPredicate{},
+			value:     upstreams,
+			args:      strList("host", "port"),
+			want:      true,
+		},
+		{
+			name:      "uniqueBy returns false when objects share a key value",
+			predicate: &collection.UniqueByPredicate{},
+			value:     upstreams,
+			args:      tests.PrimVal("host", &typed.StringTyped{}),
+			want:      false,
+		},
+		{
+			name:      "uniqueBy returns false and error when an object lacks the key",
+			predicate: &collection.UniqueByPredicate{},
+			value:     upstreams,
+			args:      tests.PrimVal("weight", &typed.StringTyped{}),
+			want:      false,
+			wantErr:   true,
+		},
+		{
+			name:      "containsObjectMatching returns true when an object matches nested partial object",
+			predicate: &collection.ContainsObjectMatchingPredicate{},
+			value:     upstreams,
+			args:      mapVal(map[string]any{"host": "a.internal", "tls": map[string]any{"enabled": true}}),
+			want:      true,
+		},
+		{
+			name:      "containsObjectMatching returns false when no object matches all fields",
+			predicate: &collection.ContainsObjectMatchingPredicate{},
+			value:     upstreams,
+			args:      mapVal(map[string]any{"host": "b.internal", "port": 8081}),
+			want:      false,
+		},
+		{
+			name:      "containsObjectMatching returns false and error when argument is not an object",
+			predicate: &collection.ContainsObjectMatchingPredicate{},
+			value:     upstreams,
+			args:      tests.PrimVal("a.internal", &typed.StringTyped{}),
+			want:      false,
+			wantErr:   true,
+		},
+		{
+			name:      "hasKeys returns true when all keys are present",
+			predicate: &collection.HasKeysPredicate{},
+			value:     server,
+			args:      strList("listen", "port"),
+			want:      true,
+		},
+		{
+			name:      "hasKeys returns false when a key is missing",
+			predicate: &collection.HasKeysPredicate{},
+			value:     server,
+			args:      strList("listen", "tls"),
+			want:      false,
+		},
+		{
+			name:      "keysMatch returns true when all keys match the expression",
+			predicate: &collection.KeysMatchPredicate{},
+			value:     server,
+			args:      tests.PrimVal("^[a-z]+$", &typed.StringTyped{}),
+			want:      true,
+		},
+		{
+			name:      "keysMatch returns false when a key doesn't match the expression",
+			predicate: &collection.KeysMatchPredicate{},
+			value:     server,
+			args:      tests.PrimVal("^(listen|port)$", &typed.StringTyped{}),
+			want:      false,
+		},
+		{
+			name:      "noExtraKeys returns true when all keys are allowed",
+			predicate: &collection.NoExtraKeysPredicate{},
+			value:     server,
+			args:      strList("listen", "port", "debug", "tls"),
+			want:      true,
+		},
+		{
+			name:      "noExtraKeys returns false when object has an unexpected key",
+			predicate: &collection.NoExtraKeysPredicate{},
+			value:     server,
+			args:      strList("listen", "port"),
+			want:      false,
+		},
+		{
+			name:      "noExtraKeys returns false and error when value is not an object",
+			predicate: &collection.NoExtraKeysPredicate{},
+			value:     strList("listen"),
+			args:      strList("listen"),
+			want:      false,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.predicate.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%T.Test() error = %v, wantErr %v", tt.predicate, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("%T.Test() = %v, want %v", tt.predicate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectionUniqueByPredicateReportsDuplicates(t *testing.T) {
+	users := typed.NewListValue(
+		[]typed.Valuable{
+			mapVal(map[string]any{"name": "alice"}),
+			mapVal(map[string]any{"name": "bob"}),
+			mapVal(map[string]any{"name": "alice"}),
+		}, &typed.MapTyped{ElementsType: &typed.StringTyped{}},
+	)
+
+	uniqueByPrd := &collection.UniqueByPredicate{}
+	if ok, err := uniqueByPrd.Test(users, tests.PrimVal("name", &typed.StringTyped{})); ok || err != nil {
+		t.Fatalf("collection.UniqueByPredicate.Test() = %v, %v, want false, <nil>", ok, err)
+	}
+
+	findings := uniqueByPrd.Findings()
+	if len(findings) != 1 || findings[0].Path != "2" {
+		t.Errorf("expected a single finding at 2, got %v", findings)
+	}
+}
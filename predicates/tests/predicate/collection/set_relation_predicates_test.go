@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/collection"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func TestCollectionSetRelationPredicates(t *testing.T) {
+	regions := strList("eu-west-1", "eu-central-1", "eu-west-1")
+
+	tests := []struct {
+		name      string
+		predicate predicates.Predicate
+		value     typed.Valuable
+		args      typed.Valuable
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "allEqual returns true when all elements are equal",
+			predicate: &collection.AllEqualPredicate{},
+			value:     strList("v1.2.0", "v1.2.0", "v1.2.0"),
+			want:      true,
+		},
+		{
+			name:      "allEqual returns false when elements differ",
+			predicate: &collection.AllEqualPredicate{},
+			value:     regions,
+			want:      false,
+		},
+		{
+			name:      "allEqual returns true when map values are equal",
+			predicate: &collection.AllEqualPredicate{},
+			value: typed.NewMapValue(
+				map[string]typed.Valuable{
+					"api":    tests.PrimVal(3, &typed.NumberTyped{}),
+					"worker": tests.PrimVal(3, &typed.NumberTyped{}),
+				}, &typed.NumberTyped{},
+			),
+			want: true,
+		},
+		{
+			name:      "intersects returns true when collections share an element",
+			predicate: &collection.IntersectsPredicate{},
+			value:     regions,
+			args:      strList("us-east-1", "eu-central-1"),
+			want:      true,
+		},
+		{
+			name:      "intersects returns false when collections share no elements",
+			predicate: &collection.IntersectsPredicate{},
+			value:     regions,
+			args:      strList("us-east-1"),
+			want:      false,
+		},
+		{
+			name:      "disjointFrom returns true when collections share no elements",
+			predicate: &collection.DisjointFromPredicate{},
+			value:     regions,
+			args:      strList("us-east-1", "us-west-2"),
+			want:      true,
+		},
+		{
+			name:      "disjointFrom returns false when collections share an element",
+			predicate: &collection.DisjointFromPredicate{},
+			value:     regions,
+			args:      strList("eu-west-1"),
+			want:      false,
+		},
+		{
+			name:      "countOf returns true when element occurs the expected number of times",
+			predicate: &collection.CountOfPredicate{},
+			value:     regions,
+			args: typed.NewListValue(
+				[]typed.Valuable{tests.PrimVal("eu-west-1", &typed.StringTyped{}), tests.PrimVal(2, &typed.NumberTyped{})},
+				&typed.GenericTyped{},
+			),
+			want: true,
+		},
+		{
+			name:      "countOf returns false when element occurs a different number of times",
+			predicate: &collection.CountOfPredicate{},
+			value:     regions,
+			args: typed.NewListValue(
+				[]typed.Valuable{tests.PrimVal("eu-central-1", &typed.StringTyped{}), tests.PrimVal(2, &typed.NumberTyped{})},
+				&typed.GenericTyped{},
+			),
+			want: false,
+		},
+		{
+			name:      "countOf returns false and error when count is missing",
+			predicate: &collection.CountOfPredicate{},
+			value:     regions,
+			args:      tests.PrimVal("eu-west-1", &typed.StringTyped{}),
+			want:      false,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.predicate.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%T.Test() error = %v, wantErr %v", tt.predicate, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("%T.Test() = %v, want %v", tt.predicate, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/date"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func strVal(s string) typed.Valuable {
+	return tests.PrimVal(s, &typed.StringTyped{})
+}
+
+func numVal(n float64) typed.Valuable {
+	return tests.PrimVal(n, &typed.NumberTyped{})
+}
+
+func optsVal(fields map[string]string) typed.Valuable {
+	elements := make(map[string]typed.Valuable, len(fields))
+	for key, field := range fields {
+		elements[key] = strVal(field)
+	}
+	return typed.NewMapValue(elements, &typed.StringTyped{})
+}
+
+func TestDatePredicates(t *testing.T) {
+	nextWeek := time.Now().Add(7 * 24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		predicate predicates.Predicate
+		value     typed.Valuable
+		args      typed.Valuable
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "valid returns true for an RFC3339 date",
+			predicate: &date.DateIsValidPredicate{},
+			value:     strVal("2024-06-30T12:00:00+02:00"),
+			want:      true,
+		},
+		{
+			name:      "valid detects an RFC1123 date",
+			predicate: &date.DateIsValidPredicate{},
+			value:     strVal("Sun, 30 Jun 2024 12:00:00 GMT"),
+			want:      true,
+		},
+		{
+			name:      "valid returns error when date doesn't match the layout",
+			predicate: &date.DateIsValidPredicate{},
+			value:     strVal("2024-06-30"),
+			args:      optsVal(map[string]string{"layout": "02/01/2006"}),
+			wantErr:   true,
+		},
+		{
+			name:      "valid returns error for an unrecognized date",
+			predicate: &date.DateIsValidPredicate{},
+			value:     strVal("next tuesday"),
+			wantErr:   true,
+		},
+		{
+			name:      "before compares dates of different layouts",
+			predicate: &date.DateIsBeforePredicate{},
+			value:     strVal("2024-06-30"),
+			args:      strVal("Mon, 01 Jul 2024 00:00:00 GMT"),
+			want:      true,
+		},
+		{
+			name:      "before uses a named layout and time zone",
+			predicate: &date.DateIsBeforePredicate{},
+			value:     strVal("2024-06-30 23:30:00"),
+			args: optsVal(map[string]string{
+				"date":     "2024-06-30T22:00:00Z",
+				"layout":   "DateTime",
+				"timezone": "Europe/Sofia",
+			}),
+			want: true,
+		},
+		{
+			name:      "after returns true for an epoch after the date",
+			predicate: &date.DateIsAfterPredicate{},
+			value:     numVal(1719748800),
+			args:      strVal("2024-06-30T11:59:59Z"),
+			want:      true,
+		},
+		{
+			name:      "same compares epoch milliseconds with a date",
+			predicate: &date.DateIsPredicate{},
+			value:     numVal(1719748800000),
+			args:      strVal("2024-06-30T12:00:00Z"),
+			want:      true,
+		},
+		{
+			name:      "before returns true when date is earlier than a relative date",
+			predicate: &date.DateIsBeforePredicate{},
+			value:     strVal(nextWeek.Format(time.RFC3339)),
+			args:      strVal("now+30d"),
+			want:      true,
+		},
+		{
+			name:      "after returns false when date is earlier than a relative date",
+			predicate: &date.DateIsAfterPredicate{},
+			value:     strVal(nextWeek.Format(time.RFC3339)),
+			args:      strVal("now + 2w"),
+			want:      false,
+		},
+		{
+			name:      "after returns error for an invalid relative date",
+			predicate: &date.DateIsAfterPredicate{},
+			value:     strVal("2024-06-30"),
+			args:      strVal("now*2"),
+			wantErr:   true,
+		},
+		{
+			name:      "within returns true for a date within a relative interval",
+			predicate: &date.DateIsWithinIntervalPredicate{},
+			value:     strVal(nextWeek.Format(time.RFC1123)),
+			args:      typed.NewListValue([]typed.Valuable{strVal("now"), strVal("now+30d")}, &typed.StringTyped{}),
+			want:      true,
+		},
+		{
+			name:      "within returns false for a date outside the interval",
+			predicate: &date.DateIsWithinIntervalPredicate{},
+			value:     strVal("2023-12-31"),
+			args:      optsVal(map[string]string{"from": "2024-01-01", "to": "now-1y"}),
+			want:      false,
+		},
+		{
+			name:      "within returns error when interval end is missing",
+			predicate: &date.DateIsWithinIntervalPredicate{},
+			value:     strVal("2024-06-30"),
+			args:      optsVal(map[string]string{"from": "2024-01-01"}),
+			wantErr:   true,
+		},
+		{
+			name:      "inFuture returns true for a future date",
+			predicate: &date.DateIsInFuture{},
+			value:     strVal(nextWeek.Format(time.RFC3339)),
+			want:      true,
+		},
+		{
+			name:      "inFuture returns false for a past epoch",
+			predicate: &date.DateIsInFuture{},
+			value:     numVal(0),
+			want:      false,
+		},
+		{
+			name:      "inFuture returns true for a date after a relative threshold",
+			predicate: &date.DateIsInFuture{},
+			value:     strVal("now+45d"),
+			args:      strVal("now+30d"),
+			want:      true,
+		},
+		{
+			name:      "inFuture returns false for a date before a relative threshold",
+			predicate: &date.DateIsInFuture{},
+			value:     strVal(nextWeek.Format(time.RFC3339)),
+			args:      strVal("now+30d"),
+			want:      false,
+		},
+		{
+			name:      "inFuture compares against an absolute threshold",
+			predicate: &date.DateIsInFuture{},
+			value:     strVal("2024-06-30"),
+			args:      strVal("2024-01-01"),
+			want:      true,
+		},
+		{
+			name:      "inFuture reads the threshold and options from an object",
+			predicate: &date.DateIsInFuture{},
+			value:     strVal("30/06/2024"),
+			args:      optsVal(map[string]string{"date": "2024-07-01", "layout": "02/01/2006"}),
+			want:      false,
+		},
+		{
+			name:      "inFuture defaults to now when the object has no threshold",
+			predicate: &date.DateIsInFuture{},
+			value:     strVal("30/06/2024"),
+			args:      optsVal(map[string]string{"layout": "02/01/2006"}),
+			want:      false,
+		},
+		{
+			name:      "inFuture returns error for an invalid threshold",
+			predicate: &date.DateIsInFuture{},
+			value:     strVal(nextWeek.Format(time.RFC3339)),
+			args:      strVal("now*30d"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.predicate.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%T.Test() error = %v, wantErr %v", tt.predicate, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("%T.Test() = %v, want %v", tt.predicate, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/conformize/conformize/common/functions"
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates/predicate/macro"
+	"github.com/conformize/conformize/predicates/predicatefactory"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func TestSubstitute(t *testing.T) {
+	bindings := map[string]any{"domain": "example.com", "port": 443.0}
+	tests := []struct {
+		name string
+		raw  any
+		want any
+	}{
+		{name: "keeps the type of a whole placeholder", raw: "{{ port }}", want: 443.0},
+		{name: "formats values into text", raw: "^https://.*\\.{{domain}}:{{ port }}$", want: "^https://.*\\.example.com:443$"},
+		{name: "substitutes within lists", raw: []any{"{{ port }}", 8443.0}, want: []any{443.0, 8443.0}},
+		{name: "substitutes within maps", raw: map[any]any{"host": "{{ domain }}"}, want: map[string]any{"host": "example.com"}},
+		{name: "leaves text without placeholders intact", raw: "plain", want: "plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := macro.Substitute(tt.raw, bindings); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Substitute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBindArguments(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters []string
+		args       any
+		want       map[string]any
+		wantErr    bool
+	}{
+		{name: "binds no parameters", want: map[string]any{}},
+		{name: "rejects arguments without parameters", args: "unexpected", wantErr: true},
+		{name: "binds a single parameter directly", parameters: []string{"domain"}, args: "example.com", want: map[string]any{"domain": "example.com"}},
+		{name: "binds a single parameter by name", parameters: []string{"domain"}, args: map[any]any{"domain": "example.com"}, want: map[string]any{"domain": "example.com"}},
+		{name: "binds parameters by name", parameters: []string{"min", "max"}, args: map[string]any{"min": 1.0, "max": 10.0}, want: map[string]any{"min": 1.0, "max": 10.0}},
+		{name: "rejects missing parameter", parameters: []string{"min", "max"}, args: map[string]any{"min": 1.0}, wantErr: true},
+		{name: "rejects unknown parameter", parameters: []string{"min", "max"}, args: map[string]any{"min": 1.0, "max": 10.0, "step": 2.0}, wantErr: true},
+		{name: "rejects positional arguments for several parameters", parameters: []string{"min", "max"}, args: []any{1.0, 10.0}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := macro.BindArguments(tt.parameters, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BindArguments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BindArguments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMacroPredicate(t *testing.T) {
+	secureUrlConditions := []macro.Condition{
+		{Predicate: "matches", Arguments: "^https://"},
+		{Predicate: "matches", Arguments: "\\.{{ domain }}(/|$)"},
+	}
+
+	tests := []struct {
+		name         string
+		operator     macro.Operator
+		parameters   []string
+		conditions   []macro.Condition
+		value        typed.Valuable
+		args         any
+		want         bool
+		wantFindings int
+		wantErr      bool
+	}{
+		{
+			name:       "allOf succeeds when all conditions are satisfied",
+			operator:   macro.AllOf,
+			parameters: []string{"domain"},
+			conditions: secureUrlConditions,
+			value:      tests.PrimVal("https://api.example.com/v1", &typed.StringTyped{}),
+			args:       "example.com",
+			want:       true,
+		},
+		{
+			name:         "allOf fails and reports unsatisfied conditions",
+			operator:     macro.AllOf,
+			parameters:   []string{"domain"},
+			conditions:   secureUrlConditions,
+			value:        tests.PrimVal("http://api.other.org", &typed.StringTyped{}),
+			args:         map[string]any{"domain": "example.com"},
+			want:         false,
+			wantFindings: 2,
+		},
+		{
+			name:       "anyOf succeeds when a condition is satisfied",
+			operator:   macro.AnyOf,
+			parameters: []string{"domain"},
+			conditions: secureUrlConditions,
+			value:      tests.PrimVal("http://api.example.com", &typed.StringTyped{}),
+			args:       "example.com",
+			want:       true,
+		},
+		{
+			name:         "anyOf fails when no condition is satisfied",
+			operator:     macro.AnyOf,
+			parameters:   []string{"domain"},
+			conditions:   secureUrlConditions,
+			value:        tests.PrimVal("http://api.other.org", &typed.StringTyped{}),
+			args:         "example.com",
+			want:         false,
+			wantFindings: 1,
+		},
+		{
+			name:     "noneOf fails when a condition is satisfied",
+			operator: macro.NoneOf,
+			conditions: []macro.Condition{
+				{Predicate: "eq", Arguments: "latest"},
+				{Predicate: "matches", Arguments: "-SNAPSHOT$"},
+			},
+			value:        tests.PrimVal("latest", &typed.StringTyped{}),
+			want:         false,
+			wantFindings: 1,
+		},
+		{
+			name:     "noneOf succeeds when no condition is satisfied",
+			operator: macro.NoneOf,
+			conditions: []macro.Condition{
+				{Predicate: "eq", Arguments: "latest"},
+			},
+			value: tests.PrimVal("1.2.3", &typed.StringTyped{}),
+			want:  true,
+		},
+		{
+			name:       "fails with error for missing arguments",
+			operator:   macro.AllOf,
+			parameters: []string{"min", "max"},
+			conditions: []macro.Condition{{Predicate: "withinRange", Arguments: []any{"{{ min }}", "{{ max }}"}}},
+			value:      tests.PrimVal(5, &typed.NumberTyped{}),
+			args:       map[string]any{"min": 1.0},
+			wantErr:    true,
+		},
+		{
+			name:       "fails with error for unknown predicate",
+			operator:   macro.AllOf,
+			conditions: []macro.Condition{{Predicate: "unknownPredicate"}},
+			value:      tests.PrimVal("value", &typed.StringTyped{}),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var args typed.Valuable
+			if tt.args != nil {
+				var err error
+				if args, err = functions.ParseRawValue(tt.args); err != nil {
+					t.Fatalf("ParseRawValue() error = %v", err)
+				}
+			}
+
+			prd := &macro.MacroPredicate{
+				Name:             "testPredicate",
+				Parameters:       tt.parameters,
+				Operator:         tt.operator,
+				Conditions:       tt.conditions,
+				PredicateBuilder: predicatefactory.Instance(),
+			}
+			got, err := prd.Test(tt.value, args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MacroPredicate.Test() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MacroPredicate.Test() = %v, want %v", got, tt.want)
+			}
+			if len(prd.Findings()) != tt.wantFindings {
+				t.Errorf("MacroPredicate.Findings() = %v, want %d findings", prd.Findings(), tt.wantFindings)
+			}
+		})
+	}
+}
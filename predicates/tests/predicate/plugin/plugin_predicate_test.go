@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	"github.com/conformize/conformize/predicates/predicate/plugin"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+const pluginScript = `#!/bin/sh
+request=$(cat)
+case "$request" in
+  *'"command":"describe"'*)
+    echo '{"description":"Topic follows taxonomy","value":{"type":"list","required":true},"arguments":{"type":"string"}}' ;;
+  *'"arguments":"crash"'*)
+    echo 'plugin crashed' >&2
+    exit 2 ;;
+  *'"arguments":"hang"'*)
+    sleep 5 ;;
+  *'"arguments":"garbage"'*)
+    echo 'not json' ;;
+  *'Payments-Refunds'*)
+    echo '{"result":false,"findings":[{"path":"1","message":"topic does not follow the taxonomy"}]}' ;;
+  *)
+    echo '{"result":true}' ;;
+esac
+`
+
+func newTestPlugin(t *testing.T, timeout string) *plugin.Plugin {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin test script requires a POSIX shell")
+	}
+
+	workDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(workDir, "taxonomy.sh"), []byte(pluginScript), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	cwd := util.GetWorkDir()
+	util.SetWorkDir(workDir)
+	t.Cleanup(func() { util.SetWorkDir(cwd) })
+
+	prdPlugin, err := plugin.New("topicTaxonomy", "taxonomy.sh", nil, timeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return prdPlugin
+}
+
+func topics(names ...string) typed.Valuable {
+	elements := make([]typed.Valuable, 0, len(names))
+	for _, name := range names {
+		elements = append(elements, tests.PrimVal(name, &typed.StringTyped{}))
+	}
+	return typed.NewListValue(elements, &typed.StringTyped{})
+}
+
+func TestPluginPredicate(t *testing.T) {
+	prdPlugin := newTestPlugin(t, "1s")
+
+	tests := []struct {
+		name         string
+		value        typed.Valuable
+		args         typed.Valuable
+		want         bool
+		wantErr      string
+		wantFindings []string
+	}{
+		{
+			name:  "returns true when plugin accepts the value",
+			value: topics("payments.invoices.v1"),
+			want:  true,
+		},
+		{
+			name:         "returns false and plugin findings when plugin rejects the value",
+			value:        topics("payments.invoices.v1", "Payments-Refunds"),
+			want:         false,
+			wantFindings: []string{"1: topic does not follow the taxonomy"},
+		},
+		{
+			name:    "returns error with plugin output when plugin crashes",
+			value:   topics("payments.invoices.v1"),
+			args:    tests.PrimVal("crash", &typed.StringTyped{}),
+			wantErr: "plugin crashed",
+		},
+		{
+			name:    "returns error when plugin exceeds its timeout",
+			value:   topics("payments.invoices.v1"),
+			args:    tests.PrimVal("hang", &typed.StringTyped{}),
+			wantErr: "timed out",
+		},
+		{
+			name:    "returns error when plugin response isn't valid JSON",
+			value:   topics("payments.invoices.v1"),
+			args:    tests.PrimVal("garbage", &typed.StringTyped{}),
+			wantErr: "invalid response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pluginPrd := &plugin.PluginPredicate{Plugin: prdPlugin}
+			got, err := pluginPrd.Test(tt.value, tt.args)
+			if (err != nil) != (len(tt.wantErr) > 0) {
+				t.Fatalf("plugin.PluginPredicate.Test() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("plugin.PluginPredicate.Test() error = %v, want error containing %q", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("plugin.PluginPredicate.Test() = %v, want %v", got, tt.want)
+			}
+
+			findings := pluginPrd.Findings()
+			if len(findings) != len(tt.wantFindings) {
+				t.Fatalf("expected %d findings, got %d", len(tt.wantFindings), len(findings))
+			}
+
+			for idx, finding := range findings {
+				if finding.String() != tt.wantFindings[idx] {
+					t.Errorf("expected finding %q, got %q", tt.wantFindings[idx], finding.String())
+				}
+			}
+		})
+	}
+}
+
+func TestPluginPredicateSchema(t *testing.T) {
+	prdPlugin := newTestPlugin(t, "")
+	if prdPlugin.Timeout != plugin.DefaultTimeout {
+		t.Errorf("expected default timeout %s, got %s", plugin.DefaultTimeout, prdPlugin.Timeout)
+	}
+
+	prdSchema := (&plugin.PluginPredicate{Plugin: prdPlugin}).Schema()
+	if prdSchema.GetDescription() != "Topic follows taxonomy" {
+		t.Errorf("unexpected schema description %q", prdSchema.GetDescription())
+	}
+
+	attrs := prdSchema.GetAttributes()
+	if _, ok := attrs["Value"]; !ok {
+		t.Errorf("expected schema to describe the value")
+	}
+
+	if _, ok := attrs["Arguments"]; !ok {
+		t.Errorf("expected schema to describe the arguments")
+	}
+}
+
+func TestNewPluginRejectsNonExecutables(t *testing.T) {
+	workDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(workDir, "plugin.txt"), []byte("text"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cwd := util.GetWorkDir()
+	util.SetWorkDir(workDir)
+	defer util.SetWorkDir(cwd)
+
+	if runtime.GOOS != "windows" {
+		if _, err := plugin.New("text", "plugin.txt", nil, ""); err == nil {
+			t.Errorf("expected error for a non-executable plugin")
+		}
+	}
+
+	if _, err := plugin.New("missing", "missing.sh", nil, ""); err == nil {
+		t.Errorf("expected error for a missing plugin")
+	}
+
+	if _, err := plugin.New("dir", ".", nil, "-1s"); err == nil {
+		t.Errorf("expected error for a directory")
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/common/util"
+	"github.com/conformize/conformize/predicates/predicate/policy"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+const containersPolicy = `package k8s.containers
+
+deny[msg] {
+	container := input.containers[_]
+	container.privileged
+	msg := sprintf("container '%s' must not be privileged", [container.name])
+}
+`
+
+const containersPolicyV1 = `package k8s.limits
+
+deny contains {"msg": msg, "path": sprintf("containers.%d", [i])} if {
+	some i, container in input.containers
+	not container.limits
+	msg := sprintf("container '%s' has no resource limits", [container.name])
+}
+`
+
+func strVal(s string) typed.Valuable {
+	return tests.PrimVal(s, &typed.StringTyped{})
+}
+
+func container(name string, privileged bool, limits bool) typed.Valuable {
+	fields := map[string]typed.Valuable{
+		"name":       strVal(name),
+		"privileged": tests.PrimVal(privileged, &typed.BooleanTyped{}),
+	}
+	if limits {
+		fields["limits"] = typed.NewMapValue(map[string]typed.Valuable{"cpu": strVal("500m")}, &typed.StringTyped{})
+	}
+	return typed.NewMapValue(fields, &typed.GenericTyped{})
+}
+
+func pod(containers ...typed.Valuable) typed.Valuable {
+	return typed.NewMapValue(map[string]typed.Valuable{
+		"containers": typed.NewListValue(containers, &typed.GenericTyped{}),
+	}, &typed.GenericTyped{})
+}
+
+func regoArgs(fields map[string]string) typed.Valuable {
+	elements := make(map[string]typed.Valuable, len(fields))
+	for key, field := range fields {
+		elements[key] = strVal(field)
+	}
+	return typed.NewMapValue(elements, &typed.StringTyped{})
+}
+
+func TestRegoPredicate(t *testing.T) {
+	workDir := t.TempDir()
+	policyDir := filepath.Join(workDir, "policies")
+	if err := os.MkdirAll(policyDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(policyDir, "containers.rego"), []byte(containersPolicy), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(policyDir, "containers_test.rego"), []byte("not a policy"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cwd := util.GetWorkDir()
+	util.SetWorkDir(workDir)
+	defer util.SetWorkDir(cwd)
+
+	tests := []struct {
+		name         string
+		value        typed.Valuable
+		args         typed.Valuable
+		want         bool
+		wantErr      bool
+		wantFindings []string
+	}{
+		{
+			name:  "returns true when policy reports no violations",
+			value: pod(container("app", false, true)),
+			args:  strVal("policies/containers.rego"),
+			want:  true,
+		},
+		{
+			name:         "returns false and a finding per deny message",
+			value:        pod(container("app", false, true), container("sidecar", true, true), container("debug", true, true)),
+			args:         strVal("policies"),
+			want:         false,
+			wantFindings: []string{"container 'debug' must not be privileged", "container 'sidecar' must not be privileged"},
+		},
+		{
+			name:         "evaluates inline module in Rego v1 syntax and reports violation paths",
+			value:        pod(container("app", false, true), container("sidecar", false, false)),
+			args:         regoArgs(map[string]string{"module": containersPolicyV1}),
+			want:         false,
+			wantFindings: []string{"containers.1: container 'sidecar' has no resource limits"},
+		},
+		{
+			name:  "evaluates boolean query",
+			value: pod(container("app", true, true)),
+			args: regoArgs(map[string]string{
+				"policy": "policies/containers.rego",
+				"query":  "count(data.k8s.containers.deny) == 0",
+			}),
+			want:         false,
+			wantFindings: []string{"count(data.k8s.containers.deny) == 0 is false"},
+		},
+		{
+			name:  "returns false when query result is undefined",
+			value: pod(container("app", false, true)),
+			args: regoArgs(map[string]string{
+				"module": "package k8s.pods\nallow { input.approved }",
+				"query":  "data.k8s.pods.allow",
+			}),
+			want:         false,
+			wantFindings: []string{"data.k8s.pods.allow is undefined"},
+		},
+		{
+			name:  "returns error when query refers to a misspelled rule",
+			value: pod(container("app", false, true)),
+			args: regoArgs(map[string]string{
+				"policy": "policies/containers.rego",
+				"query":  "data.k8s.containers.denyy",
+			}),
+			wantErr: true,
+		},
+		{
+			name:    "returns error when policy doesn't define the default rule",
+			value:   pod(container("app", false, true)),
+			args:    regoArgs(map[string]string{"module": "package k8s.pods\nallow { input.approved }"}),
+			wantErr: true,
+		},
+		{
+			name:    "returns error when policy file doesn't exist",
+			value:   pod(container("app", false, true)),
+			args:    strVal("policies/missing.rego"),
+			wantErr: true,
+		},
+		{
+			name:    "returns error when policy doesn't parse",
+			value:   pod(container("app", false, true)),
+			args:    regoArgs(map[string]string{"module": "package broken\ndeny[msg] {"}),
+			wantErr: true,
+		},
+		{
+			name:    "returns error when policy uses network builtins",
+			value:   pod(container("app", false, true)),
+			args:    regoArgs(map[string]string{"module": "package net\ndeny[msg] { http.send({\"method\": \"get\", \"url\": \"http://localhost\"}); msg := \"x\" }"}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regoPrd := &policy.RegoPredicate{}
+			got, err := regoPrd.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("policy.RegoPredicate.Test() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("policy.RegoPredicate.Test() = %v, want %v", got, tt.want)
+			}
+
+			findings := regoPrd.Findings()
+			if len(findings) != len(tt.wantFindings) {
+				t.Fatalf("expected %d findings, got %d", len(tt.wantFindings), len(findings))
+			}
+
+			for idx, finding := range findings {
+				if finding.String() != tt.wantFindings[idx] {
+					t.Errorf("expected finding %q, got %q", tt.wantFindings[idx], finding.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/primitive"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func numVal(v any) typed.Valuable {
+	return tests.PrimVal(v, &typed.NumberTyped{})
+}
+
+func numList(values ...any) typed.Valuable {
+	elements := make([]typed.Valuable, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, numVal(v))
+	}
+	return &typed.ListValue{Elements: elements, ElementsType: &typed.NumberTyped{}}
+}
+
+func TestNumberPrecisionPredicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate predicates.Predicate
+		value     typed.Valuable
+		args      typed.Valuable
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "isInteger returns true for an integral value",
+			predicate: &primitive.NumberIsIntegerPredicate[float64]{},
+			value:     numVal(8080),
+			want:      true,
+		},
+		{
+			name:      "isInteger returns false for a fractional value",
+			predicate: &primitive.NumberIsIntegerPredicate[float64]{},
+			value:     numVal(0.5),
+			want:      false,
+		},
+		{
+			name:      "isInteger returns error when value is not a number",
+			predicate: &primitive.NumberIsIntegerPredicate[float64]{},
+			value:     tests.PrimVal("8080", &typed.StringTyped{}),
+			wantErr:   true,
+		},
+		{
+			name:      "multipleOf returns true when value is a multiple of the divisor",
+			predicate: &primitive.NumberIsMultipleOfPredicate[float64]{},
+			value:     numVal(4096),
+			args:      numVal(1024),
+			want:      true,
+		},
+		{
+			name:      "multipleOf tolerates floating point error for fractional divisors",
+			predicate: &primitive.NumberIsMultipleOfPredicate[float64]{},
+			value:     numVal(0.3),
+			args:      numVal(0.1),
+			want:      true,
+		},
+		{
+			name:      "multipleOf returns false when value is not a multiple of the divisor",
+			predicate: &primitive.NumberIsMultipleOfPredicate[float64]{},
+			value:     numVal(1000),
+			args:      numVal(1024),
+			want:      false,
+		},
+		{
+			name:      "multipleOf returns error when divisor is zero",
+			predicate: &primitive.NumberIsMultipleOfPredicate[float64]{},
+			value:     numVal(10),
+			args:      numVal(0),
+			wantErr:   true,
+		},
+		{
+			name:      "maxDecimalPlaces returns true when value has fewer decimal places",
+			predicate: &primitive.NumberMaxDecimalPlacesPredicate[float64]{},
+			value:     numVal(19.9),
+			args:      numVal(2),
+			want:      true,
+		},
+		{
+			name:      "maxDecimalPlaces returns false when value has more decimal places",
+			predicate: &primitive.NumberMaxDecimalPlacesPredicate[float64]{},
+			value:     numVal(0.125),
+			args:      numVal(2),
+			want:      false,
+		},
+		{
+			name:      "maxDecimalPlaces returns error when places is negative",
+			predicate: &primitive.NumberMaxDecimalPlacesPredicate[float64]{},
+			value:     numVal(1.5),
+			args:      numVal(-1),
+			wantErr:   true,
+		},
+		{
+			name:      "isPercentage returns true for a value between 0 and 100",
+			predicate: &primitive.NumberIsPercentagePredicate[float64]{},
+			value:     numVal(75),
+			want:      true,
+		},
+		{
+			name:      "isPercentage returns false for a value above 100",
+			predicate: &primitive.NumberIsPercentagePredicate[float64]{},
+			value:     numVal(120),
+			want:      false,
+		},
+		{
+			name:      "isPercentage returns false for a value above 1 on fraction scale",
+			predicate: &primitive.NumberIsPercentagePredicate[float64]{},
+			value:     numVal(75),
+			args:      tests.PrimVal("fraction", &typed.StringTyped{}),
+			want:      false,
+		},
+		{
+			name:      "isPercentage returns true for a ratio on fraction scale",
+			predicate: &primitive.NumberIsPercentagePredicate[float64]{},
+			value:     numVal(0.75),
+			args:      tests.PrimVal("fraction", &typed.StringTyped{}),
+			want:      true,
+		},
+		{
+			name:      "isPercentage returns error for an unknown scale",
+			predicate: &primitive.NumberIsPercentagePredicate[float64]{},
+			value:     numVal(0.75),
+			args:      tests.PrimVal("permille", &typed.StringTyped{}),
+			wantErr:   true,
+		},
+		{
+			name:      "isPowerOfTwo returns true for a power of two",
+			predicate: &primitive.NumberIsPowerOfTwoPredicate[float64]{},
+			value:     numVal(512),
+			want:      true,
+		},
+		{
+			name:      "isPowerOfTwo returns false for a value which isn't a power of two",
+			predicate: &primitive.NumberIsPowerOfTwoPredicate[float64]{},
+			value:     numVal(768),
+			want:      false,
+		},
+		{
+			name:      "isPowerOfTwo returns false for a fractional power of two",
+			predicate: &primitive.NumberIsPowerOfTwoPredicate[float64]{},
+			value:     numVal(0.5),
+			want:      false,
+		},
+		{
+			name:      "approxEqual returns true when value is within tolerance",
+			predicate: &primitive.NumberIsApproxEqualPredicate[float64]{},
+			value:     numVal(0.749),
+			args:      numList(0.75, 0.01),
+			want:      true,
+		},
+		{
+			name:      "approxEqual returns false when value is outside tolerance",
+			predicate: &primitive.NumberIsApproxEqualPredicate[float64]{},
+			value:     numVal(0.7),
+			args:      numList(0.75, 0.01),
+			want:      false,
+		},
+		{
+			name:      "approxEqual returns error when tolerance is missing",
+			predicate: &primitive.NumberIsApproxEqualPredicate[float64]{},
+			value:     numVal(0.7),
+			args:      numList(0.75),
+			wantErr:   true,
+		},
+		{
+			name:      "approxEqual returns error when tolerance is negative",
+			predicate: &primitive.NumberIsApproxEqualPredicate[float64]{},
+			value:     numVal(0.7),
+			args:      numList(0.75, -0.1),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.predicate.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%T.Test() error = %v, wantErr %v", tt.predicate, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("%T.Test() = %v, want %v", tt.predicate, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/reference"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func strVal(s string) typed.Valuable {
+	return tests.PrimVal(s, &typed.StringTyped{})
+}
+
+func strList(values ...string) typed.Valuable {
+	elements := make([]typed.Valuable, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, strVal(v))
+	}
+	return typed.NewListValue(elements, &typed.StringTyped{})
+}
+
+func keysMap(keys ...string) typed.Valuable {
+	elements := make(map[string]typed.Valuable, len(keys))
+	for _, key := range keys {
+		elements[key] = strVal(key)
+	}
+	return typed.NewMapValue(elements, &typed.StringTyped{})
+}
+
+func TestReferencePredicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate predicates.Predicate
+		value     typed.Valuable
+		args      typed.Valuable
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "keyOf returns true when reference is a key of the referenced object",
+			predicate: &reference.KeyOfPredicate{},
+			value:     strVal("billing"),
+			args:      keysMap("billing", "users"),
+			want:      true,
+		},
+		{
+			name:      "keyOf returns false when a referenced key is missing",
+			predicate: &reference.KeyOfPredicate{},
+			value:     strList("billing", "orders"),
+			args:      keysMap("billing", "users"),
+			want:      false,
+		},
+		{
+			name:      "keyOf checks the keys of an object value",
+			predicate: &reference.KeyOfPredicate{},
+			value:     keysMap("users"),
+			args:      keysMap("billing", "users"),
+			want:      true,
+		},
+		{
+			name:      "keyOf matches numeric references against keys",
+			predicate: &reference.KeyOfPredicate{},
+			value:     tests.PrimVal(42, &typed.NumberTyped{}),
+			args:      keysMap("42"),
+			want:      true,
+		},
+		{
+			name:      "keyOf returns error when argument is not an object",
+			predicate: &reference.KeyOfPredicate{},
+			value:     strVal("billing"),
+			args:      strList("billing"),
+			wantErr:   true,
+		},
+		{
+			name:      "existsIn returns true when all references exist",
+			predicate: &reference.ExistsInPredicate{},
+			value:     strList("darkMode", "beta"),
+			args:      strList("beta", "darkMode", "newCheckout"),
+			want:      true,
+		},
+		{
+			name:      "existsIn returns false when a reference is dangling",
+			predicate: &reference.ExistsInPredicate{},
+			value:     strList("darkMode", "legacy"),
+			args:      strList("beta", "darkMode"),
+			want:      false,
+		},
+		{
+			name:      "existsIn matches references against map values",
+			predicate: &reference.ExistsInPredicate{},
+			value:     strVal("users"),
+			args:      keysMap("users"),
+			want:      true,
+		},
+		{
+			name:      "existsIn returns error when reference isn't primitive",
+			predicate: &reference.ExistsInPredicate{},
+			value:     typed.NewListValue([]typed.Valuable{strList("a")}, &typed.ListTyped{ElementsType: &typed.StringTyped{}}),
+			args:      strList("a"),
+			wantErr:   true,
+		},
+		{
+			name:      "existsIn returns error when argument is nil",
+			predicate: &reference.ExistsInPredicate{},
+			value:     strVal("a"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.predicate.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%T.Test() error = %v, wantErr %v", tt.predicate, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("%T.Test() = %v, want %v", tt.predicate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReferencePredicatesReportDanglingReferences(t *testing.T) {
+	keyOfPrd := &reference.KeyOfPredicate{}
+	ok, err := keyOfPrd.Test(strList("billing", "orders", "users", "audit"), keysMap("billing", "users"))
+	if err != nil {
+		t.Fatalf("reference.KeyOfPredicate.Test() error = %v", err)
+	}
+
+	if ok {
+		t.Errorf("reference.KeyOfPredicate.Test() = %v, want %v", ok, false)
+	}
+
+	findings := keyOfPrd.Findings()
+	wantPaths := []string{"1", "3"}
+	if len(findings) != len(wantPaths) {
+		t.Fatalf("expected %d findings, got %d", len(wantPaths), len(findings))
+	}
+
+	for idx, finding := range findings {
+		if finding.Path != wantPaths[idx] {
+			t.Errorf("expected finding at %s, got %s", wantPaths[idx], finding.Path)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/predicates"
+	"github.com/conformize/conformize/predicates/predicate/schedule"
+	"github.com/conformize/conformize/predicates/tests"
+)
+
+func strVal(s string) typed.Valuable {
+	return tests.PrimVal(s, &typed.StringTyped{})
+}
+
+func TestCronPredicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate predicates.Predicate
+		value     typed.Valuable
+		args      typed.Valuable
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "cronValid returns true for a 5-field expression",
+			predicate: &schedule.CronIsValidPredicate{},
+			value:     strVal("*/15 0-6 1,15 * MON-FRI"),
+			want:      true,
+		},
+		{
+			name:      "cronValid returns true for a 6-field expression with seconds",
+			predicate: &schedule.CronIsValidPredicate{},
+			value:     strVal("30 0 12 * JAN-JUN ?"),
+			want:      true,
+		},
+		{
+			name:      "cronValid returns true for a macro with time zone",
+			predicate: &schedule.CronIsValidPredicate{},
+			value:     strVal("CRON_TZ=UTC @daily"),
+			want:      true,
+		},
+		{
+			name:      "cronValid returns error for an out of range field",
+			predicate: &schedule.CronIsValidPredicate{},
+			value:     strVal("0 24 * * *"),
+			wantErr:   true,
+		},
+		{
+			name:      "cronValid returns error for wrong number of fields",
+			predicate: &schedule.CronIsValidPredicate{},
+			value:     strVal("* * *"),
+			wantErr:   true,
+		},
+		{
+			name:      "cronValid returns error for an unknown macro",
+			predicate: &schedule.CronIsValidPredicate{},
+			value:     strVal("@fortnightly"),
+			wantErr:   true,
+		},
+		{
+			name:      "cronRunsAtMostEvery returns true when runs are far enough apart",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("*/10 * * * *"),
+			args:      strVal("5m"),
+			want:      true,
+		},
+		{
+			name:      "cronRunsAtMostEvery returns false when runs are too frequent",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("* * * * *"),
+			args:      strVal("5m"),
+			want:      false,
+		},
+		{
+			name:      "cronRunsAtMostEvery returns false when a list of minutes has a short gap",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("0,2,30 * * * *"),
+			args:      strVal("5m"),
+			want:      false,
+		},
+		{
+			name:      "cronRunsAtMostEvery ignores daylight saving transitions",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("CRON_TZ=America/New_York 30 1 * * *"),
+			args:      strVal("24h"),
+			want:      true,
+		},
+		{
+			name:      "cronRunsAtMostEvery measures gaps between run days across months",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("0 0 31 * *"),
+			args:      strVal("31d"),
+			want:      true,
+		},
+		{
+			name:      "cronRunsAtMostEvery returns false when consecutive run days are too close",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("0 0 31 * *"),
+			args:      strVal("32d"),
+			want:      false,
+		},
+		{
+			name:      "cronRunsAtMostEvery measures the gap from the last run of a day to the first of the next",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("0 1,23 * * *"),
+			args:      strVal("3h"),
+			want:      false,
+		},
+		{
+			name:      "cronRunsAtMostEvery returns true for a schedule that never runs",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("0 0 30 2 *"),
+			args:      strVal("1d"),
+			want:      true,
+		},
+		{
+			name:      "cronRunsAtMostEvery returns error when duration is invalid",
+			predicate: &schedule.CronRunsAtMostEveryPredicate{},
+			value:     strVal("@hourly"),
+			args:      strVal("often"),
+			wantErr:   true,
+		},
+		{
+			name:      "cronNextRunWithin returns true when next run is within the duration",
+			predicate: &schedule.CronNextRunWithinPredicate{},
+			value:     strVal("@hourly"),
+			args:      strVal("1h"),
+			want:      true,
+		},
+		{
+			name:      "cronNextRunWithin returns false when next run is beyond the duration",
+			predicate: &schedule.CronNextRunWithinPredicate{},
+			value:     strVal("@yearly"),
+			args:      strVal("1m"),
+			want:      false,
+		},
+		{
+			name:      "cronNextRunWithin returns false for a date that never occurs",
+			predicate: &schedule.CronNextRunWithinPredicate{},
+			value:     strVal("0 0 30 2 *"),
+			args:      strVal("10y"),
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.predicate.Test(tt.value, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%T.Test() error = %v, wantErr %v", tt.predicate, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("%T.Test() = %v, want %v", tt.predicate, got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ package tests
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -36,3 +37,30 @@ func TestYamlFileUnmarshalling(t *testing.T) {
 	fmt.Println("unmarshalled content:")
 	content.PrintTree()
 }
+
+func TestYamlUnmarshallingKeepsListsOfObjects(t *testing.T) {
+	content := []byte("upstreams:\n  - host: a.internal\n    port: 8080\n  - host: b.internal\n    port: 8081\nports: [80, 443]\n")
+	var yamlUnmarshal = yaml.YamlUnmarshal{}
+	data, err := yamlUnmarshal.Unmarshal(serialization.NewBufferedData(content))
+	if err != nil {
+		t.Fatalf("Failed to unmarshal content, reason: %s", err)
+	}
+
+	upstreams, _ := data.GetChildren("upstreams")
+	expectedRows := []any{
+		map[string]any{"host": "a.internal", "port": 8080},
+		map[string]any{"host": "b.internal", "port": 8081},
+	}
+	if !reflect.DeepEqual(upstreams.First().Value, expectedRows) {
+		t.Errorf("Expected list of objects to hold its rows, got %v", upstreams.First().Value)
+	}
+
+	if hosts, found := upstreams.First().GetChildren("host"); !found || hosts.Count() != 2 {
+		t.Errorf("Expected list of objects to keep the children of its rows")
+	}
+
+	ports, _ := data.GetChildren("ports")
+	if !reflect.DeepEqual(ports.First().Value, []any{80, 443}) || len(ports.First().Children()) != 0 {
+		t.Errorf("Expected list of scalars to be kept as a value, got %v", ports.First().Value)
+	}
+}
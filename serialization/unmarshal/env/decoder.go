@@ -234,6 +234,19 @@ func parseUnquotedString(value string) (string, error) {
 }
 
 func (d *Decoder) Decode() (key *string, value any, err error) {
+	return d.decode(parseValue)
+}
+
+// DecodeString decodes the next variable keeping its value as a string, the way a shell would see it.
+func (d *Decoder) DecodeString() (key *string, value string, err error) {
+	key, rawValue, err := d.decode(func(value string) (any, error) { return parseString(value) })
+	if rawValue != nil {
+		value = rawValue.(string)
+	}
+	return key, value, err
+}
+
+func (d *Decoder) decode(parseValue func(string) (any, error)) (key *string, value any, err error) {
 	line, err := d.bufReader.ReadString('\n')
 	if err != nil {
 		if err == io.EOF {
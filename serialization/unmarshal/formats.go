@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package unmarshal
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/conformize/conformize/serialization"
+	"github.com/conformize/conformize/serialization/unmarshal/env"
+	"github.com/conformize/conformize/serialization/unmarshal/hcl"
+	"github.com/conformize/conformize/serialization/unmarshal/properties"
+	"github.com/conformize/conformize/serialization/unmarshal/toml"
+	"github.com/conformize/conformize/serialization/unmarshal/xml"
+	"github.com/conformize/conformize/serialization/unmarshal/yaml"
+)
+
+var formatsByExtension = map[string]string{
+	".yaml":       "yaml",
+	".yml":        "yaml",
+	".json":       "json",
+	".toml":       "toml",
+	".xml":        "xml",
+	".properties": "properties",
+	".env":        "dotenv",
+	".hcl":        "hcl",
+	".tf":         "hcl",
+}
+
+// FormatOf infers the format of a file from its extension.
+func FormatOf(filePath string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	if format, found := formatsByExtension[ext]; found {
+		return format, nil
+	}
+
+	if strings.HasPrefix(filepath.Base(filePath), ".env") {
+		return "dotenv", nil
+	}
+	return "", fmt.Errorf("couldn't infer format of %s, set it explicitly", filePath)
+}
+
+// ForFormat returns the unmarshaller decoding files in a format, e.g. yaml or toml.
+func ForFormat(format string) (serialization.SourceDataUnmarshaller, error) {
+	switch format {
+	case "yaml", "json":
+		return &yaml.YamlUnmarshal{}, nil
+	case "toml":
+		return &toml.TomlFilelUnmarshal{}, nil
+	case "xml":
+		return &xml.XmlFileUnmarshal{}, nil
+	case "properties":
+		return &properties.PropertiesFileUnmarshal{}, nil
+	case "dotenv":
+		return &env.EnvFileUnmarshal{}, nil
+	case "hcl":
+		return &hcl.HclFileUnmarshal{}, nil
+	}
+	return nil, fmt.Errorf("unsupported format '%s'", format)
+}
+
+// ForFile returns the unmarshaller for a file, in the given format or in the one inferred from its extension.
+func ForFile(filePath string, format string) (serialization.SourceDataUnmarshaller, error) {
+	if len(format) == 0 {
+		var err error
+		if format, err = FormatOf(filePath); err != nil {
+			return nil, err
+		}
+	}
+	return ForFormat(format)
+}
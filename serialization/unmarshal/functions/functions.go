@@ -16,7 +16,9 @@ import (
 
 func UnmarshalValue[K comparable, V any](nodeRef *ds.Node[K, V], value any) {
 	if val, ok := value.(map[K]any); !ok {
-		nodeRef.Value = value.(V)
+		if leaf, ok := value.(V); ok {
+			nodeRef.Value = leaf
+		}
 	} else {
 		for key, v := range val {
 			var childNodeRef = nodeRef.AddChild(key)
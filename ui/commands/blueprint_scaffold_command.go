@@ -83,6 +83,10 @@ func (c *blueprintScaffoldCommand) GetSubcommands() []CommandEntry {
 	return c.Subcommands
 }
 
+func (c *blueprintScaffoldCommand) AcceptsArguments() bool {
+	return false
+}
+
 func (c *blueprintScaffoldCommand) IsHidden() bool {
 	return c.Hidden
 }
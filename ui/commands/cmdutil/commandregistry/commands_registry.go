@@ -74,6 +74,11 @@ func (reg *commandRegistry) GetCommand(args []string) (commands.CommandEntry, []
 		cmdExpr.WriteString(args[idx])
 		sep = " "
 		idx++
+
+		// words following a command which takes positional arguments are passed to it
+		if cmd, ok := reg.cmds[cmdExpr.String()]; ok && cmd.AcceptsArguments() {
+			return cmd, args[idx:], true
+		}
 	}
 
 	cmdExprStr := cmdExpr.String()
@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package commandregistry
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCommandRegistryGetCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantExpr  string
+		wantArgs  []string
+		wantFound bool
+	}{
+		{
+			name:      "passes flags to the command",
+			args:      []string{"blueprint", "apply", "-f", "bp.yaml"},
+			wantExpr:  "apply",
+			wantArgs:  []string{"-f", "bp.yaml"},
+			wantFound: true,
+		},
+		{
+			name:      "passes positional arguments to a command which takes them",
+			args:      []string{"predicates", "describe", "matches", "-format", "json"},
+			wantExpr:  "describe",
+			wantArgs:  []string{"matches", "-format", "json"},
+			wantFound: true,
+		},
+		{
+			name: "doesn't recognize a command followed by unknown words",
+			args: []string{"version", "foo"},
+		},
+		{
+			name: "doesn't recognize a misspelled subcommand",
+			args: []string{"blueprint", "aply"},
+		},
+		{
+			name: "doesn't recognize unknown words before flags",
+			args: []string{"blueprint", "apply", "typo", "-f", "bp.yaml"},
+		},
+	}
+
+	reg := newCommandRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, args, found := reg.GetCommand(tt.args)
+			if found != tt.wantFound {
+				t.Fatalf("GetCommand() found = %v, want %v", found, tt.wantFound)
+			}
+
+			if !found {
+				return
+			}
+
+			if cmd.GetExpression() != tt.wantExpr {
+				t.Errorf("GetCommand() command = %s, want %s", cmd.GetExpression(), tt.wantExpr)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("GetCommand() args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
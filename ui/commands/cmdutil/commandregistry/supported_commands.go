@@ -27,6 +27,20 @@ func validateBlueprintCommandFlags() *flag.FlagSet {
 	return blueprintCommmandFlags("blueprint validate")
 }
 
+func predicatesCommandFlags(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.String("format", "text", "specifies the output format - text or JSON, e.g. -format json. Text format will be used if not specified.")
+	return flags
+}
+
+func listPredicatesCommandFlags() *flag.FlagSet {
+	return predicatesCommandFlags("predicates list")
+}
+
+func describePredicatesCommandFlags() *flag.FlagSet {
+	return predicatesCommandFlags("predicates describe")
+}
+
 func supportedCommands() []commands.CommandEntry {
 	return []commands.CommandEntry{
 		&commands.Command{
@@ -52,6 +66,25 @@ func supportedCommands() []commands.CommandEntry {
 			Hidden: false,
 		},
 		commands.BlueprintScaffoldCommand(),
+		&commands.Command{
+			Expression:  "predicates",
+			Description: "discover predicates and their arguments",
+			Subcommands: []commands.CommandEntry{
+				&commands.Command{
+					Expression:  "list",
+					Description: "list available predicates",
+					Flags:       listPredicatesCommandFlags,
+					Handler:     &commands.PredicatesListCommandHandler{},
+				},
+				&commands.Command{
+					Expression:  "describe",
+					Description: "describe a predicate, e.g. predicates describe matches",
+					Flags:       describePredicatesCommandFlags,
+					Handler:     &commands.PredicatesDescribeCommandHandler{},
+					Arguments:   true,
+				},
+			},
+		},
 		&commands.Command{
 			Expression:  "version",
 			Description: "output version information",
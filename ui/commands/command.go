@@ -25,6 +25,7 @@ type CommandEntry interface {
 	GetFlags() *flag.FlagSet
 	GetHandler() CommandHandler
 	GetSubcommands() []CommandEntry
+	AcceptsArguments() bool
 	IsHidden() bool
 	GetMeta() *schema.Data
 	Run(args []string, diags *diagnostics.Diagnostics)
@@ -39,6 +40,9 @@ type Command struct {
 	Hidden      bool
 	Flags       func() *flag.FlagSet
 	Meta        *schema.Data
+	// Arguments tells whether the command takes positional arguments before its flags,
+	// e.g. the name of a predicate.
+	Arguments bool
 }
 
 func (c *Command) Run(args []string, diags *diagnostics.Diagnostics) {
@@ -70,6 +74,10 @@ func (c *Command) GetSubcommands() []CommandEntry {
 	return c.Subcommands
 }
 
+func (c *Command) AcceptsArguments() bool {
+	return c.Arguments
+}
+
 func (c *Command) GetHandler() CommandHandler {
 	return c.Handler
 }
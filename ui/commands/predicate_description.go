@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/conformize/conformize/common/typed"
+	"github.com/conformize/conformize/internal/providers/api/schema"
+	"github.com/conformize/conformize/predicates/condition"
+	"github.com/conformize/conformize/predicates/predicatefactory"
+)
+
+type predicateAttributeDescription struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+}
+
+type predicateDescription struct {
+	Name        string                         `json:"name"`
+	Description string                         `json:"description"`
+	Deprecated  string                         `json:"deprecated,omitempty"`
+	Value       *predicateAttributeDescription `json:"value,omitempty"`
+	Arguments   *predicateAttributeDescription `json:"arguments,omitempty"`
+	Example     string                         `json:"example"`
+}
+
+func describePredicate(name string) (*predicateDescription, error) {
+	if prdCondition := condition.FromString(name); prdCondition != condition.UNKNOWN {
+		name = prdCondition.Name()
+	}
+
+	predicate, err := predicatefactory.Instance().BuildByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	prdDescription := &predicateDescription{Name: name}
+	prdSchema := predicate.Schema()
+	if prdSchema == nil {
+		prdDescription.Example = predicateExample(name, nil)
+		return prdDescription, nil
+	}
+
+	prdDescription.Description = prdSchema.GetDescription()
+	if prdSchema.IsDeprecated() {
+		prdDescription.Deprecated = prdSchema.GetDeprecationHint()
+		if len(prdDescription.Deprecated) == 0 {
+			prdDescription.Deprecated = "deprecated"
+		}
+	}
+
+	attrs := prdSchema.GetAttributes()
+	prdDescription.Value = describeAttribute(attrs["Value"])
+	prdDescription.Arguments = describeAttribute(attrs["Arguments"])
+	prdDescription.Example = predicateExample(name, attrs["Arguments"])
+	return prdDescription, nil
+}
+
+func describeAttribute(attr schema.Attributeable) *predicateAttributeDescription {
+	if attr == nil {
+		return nil
+	}
+
+	return &predicateAttributeDescription{
+		Type:        typed.TypeDescription(attr.Type()),
+		Description: attr.GetDescription(),
+		Required:    attr.IsRequired(),
+	}
+}
+
+func predicateExample(name string, args schema.Attributeable) string {
+	var prdExample strings.Builder
+	prdExample.WriteString("- $value: $source.'path'\n")
+	prdExample.WriteString(fmt.Sprintf("  %s:", name))
+	if args != nil {
+		prdExample.WriteString(fmt.Sprintf(" %s", exampleValue(args.Type())))
+	}
+	return prdExample.String()
+}
+
+func exampleValue(typ typed.Typeable) string {
+	switch t := typ.(type) {
+	case *typed.ListTyped:
+		return fmt.Sprintf("[%s, ...]", exampleValue(t.ElementsType))
+	case *typed.TupleTyped:
+		if len(t.ElementsTypes) == 1 {
+			return exampleValue(t.ElementsTypes[0])
+		}
+
+		elements := make([]string, 0, len(t.ElementsTypes))
+		for _, elementType := range t.ElementsTypes {
+			elements = append(elements, exampleValue(elementType))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
+	case *typed.VariantTyped:
+		if len(t.VariantsTypes) > 0 {
+			return exampleValue(t.VariantsTypes[0])
+		}
+	case *typed.MapTyped:
+		return fmt.Sprintf("{ <key>: %s }", exampleValue(t.ElementsType))
+	case *typed.ObjectTyped:
+		fieldNames := make([]string, 0, len(t.FieldsTypes))
+		for fieldName := range t.FieldsTypes {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+
+		fields := make([]string, 0, len(fieldNames))
+		for _, fieldName := range fieldNames {
+			fields = append(fields, fmt.Sprintf("%s: %s", fieldName, exampleValue(t.FieldsTypes[fieldName])))
+		}
+		return fmt.Sprintf("{ %s }", strings.Join(fields, ", "))
+	}
+	return fmt.Sprintf("<%s>", typed.TypeDescription(typ))
+}
+
+func (prdDescription *predicateDescription) String() string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("%s\n\n", prdDescription.Name))
+	if len(prdDescription.Description) > 0 {
+		text.WriteString(fmt.Sprintf("description: %s\n", prdDescription.Description))
+	}
+
+	if len(prdDescription.Deprecated) > 0 {
+		text.WriteString(fmt.Sprintf("deprecated:  %s\n", prdDescription.Deprecated))
+	}
+
+	writeAttribute := func(label string, attr *predicateAttributeDescription) {
+		if attr == nil {
+			text.WriteString(fmt.Sprintf("%-12s none\n", label))
+			return
+		}
+
+		requirement := "optional"
+		if attr.Required {
+			requirement = "required"
+		}
+		text.WriteString(fmt.Sprintf("%-12s %s, %s\n", label, attr.Type, requirement))
+		if len(attr.Description) > 0 {
+			text.WriteString(fmt.Sprintf("%-12s %s\n", "", attr.Description))
+		}
+	}
+	writeAttribute("value:", prdDescription.Value)
+	writeAttribute("arguments:", prdDescription.Arguments)
+
+	text.WriteString("\nexample:\n\n")
+	text.WriteString(prdDescription.Example)
+	return text.String()
+}
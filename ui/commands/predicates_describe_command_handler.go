@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/streams"
+)
+
+type PredicatesDescribeCommandHandler struct{}
+
+func (h *PredicatesDescribeCommandHandler) Handle(c CommandEntry, args []string, diags *diagnostics.Diagnostics) {
+	if len(args) == 0 || len(args[0]) == 0 || args[0][0] == '-' {
+		diags.Append(diagnostics.Builder().
+			Error().
+			Summary("Predicate name not specified, e.g. conformize predicates describe matches").
+			Build(),
+		)
+		return
+	}
+	name := args[0]
+
+	flags := c.GetFlags()
+	if err := flags.Parse(args[1:]); err != nil {
+		diags.Append(diagnostics.Builder().Error().Summary(err.Error()).Build())
+		return
+	}
+
+	outputFormat, err := predicatesOutputFormat(flags.Lookup("format").Value.String())
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Summary(err.Error()).Build())
+		return
+	}
+
+	prdDescription, err := describePredicate(name)
+	if err != nil {
+		diags.Append(diagnostics.Builder().
+			Error().
+			Summary(fmt.Sprintf("Unknown predicate '%s'", name)).
+			Details("Run 'conformize predicates list' to see the available predicates.").
+			Build(),
+		)
+		return
+	}
+
+	if outputFormat == "json" {
+		writeJSON(prdDescription, diags)
+		return
+	}
+	streams.Output().Writef("%s\n", prdDescription.String())
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2024 Hristo Paskalev
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+//
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/conformize/conformize/common/diagnostics"
+	"github.com/conformize/conformize/common/streams"
+	"github.com/conformize/conformize/predicates/predicatefactory"
+)
+
+const predicateNameColumnWidth = 24
+
+type PredicatesListCommandHandler struct{}
+
+func (h *PredicatesListCommandHandler) Handle(c CommandEntry, args []string, diags *diagnostics.Diagnostics) {
+	flags := c.GetFlags()
+	if err := flags.Parse(args); err != nil {
+		diags.Append(diagnostics.Builder().Error().Summary(err.Error()).Build())
+		return
+	}
+
+	outputFormat, err := predicatesOutputFormat(flags.Lookup("format").Value.String())
+	if err != nil {
+		diags.Append(diagnostics.Builder().Error().Summary(err.Error()).Build())
+		return
+	}
+
+	prdDescriptions := make([]*predicateDescription, 0)
+	for _, name := range predicatefactory.PredicateNames() {
+		prdDescription, err := describePredicate(name)
+		if err != nil {
+			diags.Append(diagnostics.Builder().
+				Error().
+				Summary(fmt.Sprintf("Couldn't describe predicate '%s'", name)).
+				Details(err.Error()).
+				Build(),
+			)
+			return
+		}
+		prdDescriptions = append(prdDescriptions, prdDescription)
+	}
+
+	if outputFormat == "json" {
+		writeJSON(prdDescriptions, diags)
+		return
+	}
+
+	var text strings.Builder
+	for _, prdDescription := range prdDescriptions {
+		text.WriteString(fmt.Sprintf("%-*s\t%s\n", predicateNameColumnWidth, prdDescription.Name, prdDescription.Description))
+	}
+	streams.Output().Writef("%s\n", text.String())
+}
+
+func predicatesOutputFormat(outputFormat string) (string, error) {
+	outputFormat = strings.ToLower(outputFormat)
+	if outputFormat != "text" && outputFormat != "json" {
+		return "", fmt.Errorf("invalid format '%s' specified. Supported formats are 'text' and 'json'", outputFormat)
+	}
+	return outputFormat, nil
+}
+
+func writeJSON(v any, diags *diagnostics.Diagnostics) {
+	var output bytes.Buffer
+	encoder := json.NewEncoder(&output)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		diags.Append(diagnostics.Builder().Error().Summary(err.Error()).Build())
+		return
+	}
+	streams.Output().Writef("%s", output.String())
+}
@@ -22,6 +22,9 @@ var defaultBlueprintFileLocations = []string{"./blueprint.cnfrm.json", "./bluepr
 type ValidateBlueprintCommandHandler struct{}
 
 func (h *ValidateBlueprintCommandHandler) Handle(c CommandEntry, args []string, diags *diagnostics.Diagnostics) {
+	cwd := util.GetWorkDir()
+	defer util.SetWorkDir(cwd)
+
 	flags := c.GetFlags()
 	flags.Parse(args)
 
@@ -73,6 +76,17 @@ func (h *ValidateBlueprintCommandHandler) Handle(c CommandEntry, args []string,
 		return
 	}
 
+	workDir, err := util.ResolveFileBasePath(blueprintFilePath)
+	if err != nil {
+		diags.Append(diagnostics.Builder().
+			Error().
+			Details(fmt.Sprintf("Failed to resolve working directory for blueprint file %s, reason:\n\n%s", blueprintFilePath, err.Error())).
+			Build(),
+		)
+		return
+	}
+	util.SetWorkDir(workDir)
+
 	validateDiags := blprntValid.Validate(blprnt)
 	if !validateDiags.HasErrors() {
 		diags.Append(diagnostics.Builder().